@@ -0,0 +1,7 @@
+data "appstoreconnect_app_categories" "ios" {
+  platform = "IOS"
+}
+
+output "category_ids" {
+  value = data.appstoreconnect_app_categories.ios.categories[*].id
+}
@@ -0,0 +1,4 @@
+data "appstoreconnect_build" "latest" {
+  app_id   = "1234567890"
+  platform = "IOS"
+}
@@ -0,0 +1,5 @@
+data "appstoreconnect_price_point" "coins_100_usa" {
+  in_app_purchase_id = appstoreconnect_in_app_purchase.coins_100.id
+  territory          = "USA"
+  customer_price     = "0.99"
+}
@@ -0,0 +1,3 @@
+data "appstoreconnect_sandbox_tester" "payments" {
+  email = "payments-uk@example.com"
+}
@@ -0,0 +1,8 @@
+data "appstoreconnect_territories" "all" {}
+
+locals {
+  euro_territories = [
+    for territory in data.appstoreconnect_territories.all.territories : territory.code
+    if territory.currency == "EUR"
+  ]
+}
@@ -0,0 +1,3 @@
+ephemeral "appstoreconnect_subscription_offer_code_one_time_code_values" "spring_sale_press" {
+  one_time_codes_id = appstoreconnect_subscription_offer_code_one_time_codes.spring_sale_press.id
+}
@@ -0,0 +1,13 @@
+resource "appstoreconnect_age_rating_declaration" "example" {
+  app_info_id = "12345678-90ab-cdef-1234-567890abcdef"
+
+  violence_cartoon_or_fantasy = "INFREQUENT_OR_MILD"
+  profanity_or_crude_humor    = "INFREQUENT_OR_MILD"
+  messaging_and_chat          = true
+  user_generated_content      = true
+  parental_controls           = true
+}
+
+output "age_rating" {
+  value = appstoreconnect_age_rating_declaration.example.age_rating
+}
@@ -0,0 +1,6 @@
+resource "appstoreconnect_app_availability" "example" {
+  app_id      = "1234567890"
+  territories = concat(["GBR"], local.euro_territories)
+
+  available_in_new_territories = false
+}
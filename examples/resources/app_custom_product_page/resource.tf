@@ -0,0 +1,25 @@
+resource "appstoreconnect_app_custom_product_page" "summer" {
+  app_id    = "1234567890"
+  name      = "Summer campaign"
+  visible   = true
+  deep_link = "https://example.com/summer"
+
+  localization {
+    locale           = "en-GB"
+    promotional_text = "Save 50% on annual plans all summer."
+  }
+
+  localization {
+    locale           = "fr-FR"
+    promotional_text = "50 % de réduction sur les abonnements annuels tout l'été."
+  }
+}
+
+resource "appstoreconnect_screenshot_set" "summer_iphone" {
+  custom_product_page_localization_id = appstoreconnect_app_custom_product_page.summer.localization_ids["en-GB"]
+  display_type                        = "APP_IPHONE_67"
+}
+
+output "summer_page_url" {
+  value = appstoreconnect_app_custom_product_page.summer.url
+}
@@ -0,0 +1,10 @@
+resource "appstoreconnect_app_encryption_declaration" "example" {
+  app_id          = "1234567890"
+  app_description = "Uses HTTPS and end-to-end encrypted messaging."
+
+  uses_encryption                   = true
+  contains_third_party_cryptography = true
+  available_on_french_store         = true
+
+  document_file_path = "${path.module}/compliance/ccats.pdf"
+}
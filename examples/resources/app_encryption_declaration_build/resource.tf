@@ -0,0 +1,9 @@
+data "appstoreconnect_build" "latest" {
+  app_id   = "1234567890"
+  platform = "IOS"
+}
+
+resource "appstoreconnect_app_encryption_declaration_build" "example" {
+  app_encryption_declaration_id = appstoreconnect_app_encryption_declaration.example.id
+  build_id                      = data.appstoreconnect_build.latest.id
+}
@@ -0,0 +1,31 @@
+resource "appstoreconnect_app_event" "summer_race" {
+  app_id               = "1234567890"
+  reference_name       = "Summer race 2026"
+  badge                = "CHALLENGE"
+  deep_link            = "https://example.com/events/summer-race"
+  purchase_requirement = "NO_COST_ASSOCIATED"
+  primary_locale       = "en-GB"
+  priority             = "HIGH"
+  purpose              = "KEEP_ACTIVE_USERS_INFORMED"
+
+  territory_schedule {
+    territories   = ["GBR", "IRL"]
+    publish_start = "2026-06-24T09:00:00Z"
+    event_start   = "2026-07-01T09:00:00Z"
+    event_end     = "2026-07-08T21:00:00Z"
+  }
+
+  localization {
+    locale            = "en-GB"
+    name              = "Summer Race"
+    short_description = "Race your friends all week."
+    long_description  = "Take on players around the world in a week of races to win an exclusive car."
+  }
+
+  localization {
+    locale            = "fr-FR"
+    name              = "Course d'été"
+    short_description = "Affrontez vos amis toute la semaine."
+    long_description  = "Affrontez des joueurs du monde entier pendant une semaine de courses pour gagner une voiture exclusive."
+  }
+}
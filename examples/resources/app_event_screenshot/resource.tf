@@ -0,0 +1,5 @@
+resource "appstoreconnect_app_event_screenshot" "summer_race_card" {
+  localization_id = appstoreconnect_app_event.summer_race.localization_ids["en-GB"]
+  asset_type      = "EVENT_CARD"
+  file_path       = "${path.module}/events/summer_race/en-GB/card.png"
+}
@@ -0,0 +1,6 @@
+resource "appstoreconnect_app_event_video_clip" "summer_race_details" {
+  localization_id         = appstoreconnect_app_event.summer_race.localization_ids["en-GB"]
+  asset_type              = "EVENT_DETAILS_PAGE"
+  file_path               = "${path.module}/events/summer_race/en-GB/details.mp4"
+  preview_frame_time_code = "00:00:03:00"
+}
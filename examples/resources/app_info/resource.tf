@@ -0,0 +1,8 @@
+resource "appstoreconnect_app_info" "example" {
+  app_id = "1234567890"
+
+  primary_category_id        = "GAMES"
+  primary_subcategory_one_id = "GAMES_PUZZLE"
+  primary_subcategory_two_id = "GAMES_WORD"
+  secondary_category_id      = "EDUCATION"
+}
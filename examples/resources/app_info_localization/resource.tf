@@ -0,0 +1,8 @@
+resource "appstoreconnect_app_info_localization" "en_gb" {
+  app_id = "1234567890"
+  locale = "en-GB"
+
+  name               = "Example"
+  subtitle           = "The best example app"
+  privacy_policy_url = "https://example.com/privacy"
+}
@@ -0,0 +1,5 @@
+resource "appstoreconnect_app_preview" "walkthrough" {
+  app_preview_set_id      = appstoreconnect_app_preview_set.iphone_67.id
+  file_path               = "${path.module}/previews/en-GB/iphone_67/walkthrough.mp4"
+  preview_frame_time_code = "00:00:05:00"
+}
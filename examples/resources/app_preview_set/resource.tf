@@ -0,0 +1,4 @@
+resource "appstoreconnect_app_preview_set" "iphone_67" {
+  localization_id = "6a7b8c9d-1234-5678-9abc-def012345678"
+  preview_type    = "IPHONE_67"
+}
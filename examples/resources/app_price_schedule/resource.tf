@@ -0,0 +1,41 @@
+data "appstoreconnect_price_point" "app_usa" {
+  app_id         = "1234567890"
+  territory      = "USA"
+  customer_price = "2.99"
+}
+
+data "appstoreconnect_price_point" "app_usa_black_friday" {
+  app_id         = "1234567890"
+  territory      = "USA"
+  customer_price = "0.99"
+}
+
+data "appstoreconnect_price_point" "app_gbr" {
+  app_id         = "1234567890"
+  territory      = "GBR"
+  customer_price = "2.99"
+}
+
+resource "appstoreconnect_app_price_schedule" "example" {
+  app_id         = "1234567890"
+  base_territory = "USA"
+
+  # Equalized across every territory.
+  price {
+    territory      = "USA"
+    price_point_id = data.appstoreconnect_price_point.app_usa.id
+  }
+
+  # A scheduled sale.
+  price {
+    territory      = "USA"
+    price_point_id = data.appstoreconnect_price_point.app_usa_black_friday.id
+    start_date     = "2026-11-27"
+  }
+
+  # Overrides the equalized price in the UK.
+  price {
+    territory      = "GBR"
+    price_point_id = data.appstoreconnect_price_point.app_gbr.id
+  }
+}
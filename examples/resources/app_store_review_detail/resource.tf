@@ -0,0 +1,21 @@
+variable "demo_account_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "appstoreconnect_app_store_review_detail" "example" {
+  app_store_version_id = "6a7b8c9d-1234-5678-9abc-def012345678"
+
+  contact_first_name = "Oliver"
+  contact_last_name  = "Binns"
+  contact_phone      = "+44 20 7946 0000"
+  contact_email      = "mail@oliverbinns.co.uk"
+
+  demo_account_required            = true
+  demo_account_name                = "reviewer@example.com"
+  demo_account_password_wo         = var.demo_account_password
+  demo_account_password_wo_version = 1
+
+  notes = "Use the demo account to sign in; all features are unlocked."
+}
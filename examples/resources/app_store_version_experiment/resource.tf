@@ -0,0 +1,9 @@
+resource "appstoreconnect_app_store_version_experiment" "icon_test" {
+  app_id             = "1234567890"
+  platform           = "IOS"
+  name               = "Dark icon test"
+  traffic_proportion = 30
+
+  # Start the experiment once Apple has approved it, and set to "STOPPED" to end it.
+  state = "STARTED"
+}
@@ -0,0 +1,11 @@
+resource "appstoreconnect_app_store_version_experiment_treatment" "dark_icon" {
+  experiment_id = appstoreconnect_app_store_version_experiment.icon_test.id
+  name          = "Dark icon"
+  app_icon_name = "AppIconDark"
+  locales       = ["en-GB", "fr-FR"]
+}
+
+resource "appstoreconnect_screenshot_set" "dark_icon_iphone" {
+  experiment_treatment_localization_id = appstoreconnect_app_store_version_experiment_treatment.dark_icon.localization_ids["en-GB"]
+  display_type                         = "APP_IPHONE_67"
+}
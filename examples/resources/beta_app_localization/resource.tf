@@ -0,0 +1,9 @@
+resource "appstoreconnect_beta_app_localization" "en_gb" {
+  app_id = "1234567890"
+  locale = "en-GB"
+
+  description        = "Help us test the next release before it reaches the App Store."
+  feedback_email     = "beta@oliverbinns.co.uk"
+  marketing_url      = "https://oliverbinns.co.uk"
+  privacy_policy_url = "https://oliverbinns.co.uk/privacy"
+}
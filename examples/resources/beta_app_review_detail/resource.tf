@@ -0,0 +1,19 @@
+variable "demo_account_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "appstoreconnect_beta_app_review_detail" "example" {
+  app_id = "1234567890"
+
+  contact_first_name = "Oliver"
+  contact_last_name  = "Binns"
+  contact_phone      = "+44 7700 900000"
+  contact_email      = "mail@oliverbinns.co.uk"
+
+  demo_account_required            = true
+  demo_account_name                = "reviewer@example.com"
+  demo_account_password_wo         = var.demo_account_password
+  demo_account_password_wo_version = 1
+}
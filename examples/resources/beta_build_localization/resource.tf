@@ -0,0 +1,11 @@
+data "appstoreconnect_build" "release_candidate" {
+  app_id       = "1234567890"
+  platform     = "IOS"
+  build_number = "42"
+}
+
+resource "appstoreconnect_beta_build_localization" "en_gb" {
+  build_id  = data.appstoreconnect_build.release_candidate.id
+  locale    = "en-GB"
+  whats_new = file("${path.module}/WHATS_NEW.md")
+}
@@ -0,0 +1,8 @@
+resource "appstoreconnect_beta_group" "external_partners" {
+  app_id = "1234567890"
+  name   = "External Partners"
+
+  public_link_enabled = true
+  public_link_limit   = 500
+  feedback_enabled    = true
+}
@@ -0,0 +1,11 @@
+data "appstoreconnect_build" "release_candidate" {
+  app_id       = "1234567890"
+  platform     = "IOS"
+  version      = "1.2.0"
+  build_number = "42"
+}
+
+resource "appstoreconnect_beta_group_build" "internal_qa" {
+  beta_group_id = appstoreconnect_beta_group.internal_qa.id
+  build_id      = data.appstoreconnect_build.release_candidate.id
+}
@@ -0,0 +1,5 @@
+resource "appstoreconnect_beta_group_testers" "external_partners" {
+  beta_group_id = appstoreconnect_beta_group.external_partners.id
+
+  emails = [for tester in csvdecode(file("${path.module}/testers.csv")) : tester.email]
+}
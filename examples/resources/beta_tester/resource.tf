@@ -0,0 +1,10 @@
+resource "appstoreconnect_beta_tester" "example" {
+  first_name = "Oliver"
+  last_name  = "Binns"
+
+  email = "mail@oliverbinns.co.uk"
+
+  beta_group_ids = [
+    appstoreconnect_beta_group.external_partners.id,
+  ]
+}
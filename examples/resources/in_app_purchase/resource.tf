@@ -0,0 +1,20 @@
+resource "appstoreconnect_in_app_purchase" "coins_100" {
+  app_id         = "1234567890"
+  product_id     = "com.example.app.coins.100"
+  reference_name = "100 Coins"
+  type           = "CONSUMABLE"
+
+  review_note = "Coins are added to the balance shown on the Shop screen."
+
+  localization {
+    locale      = "en-GB"
+    name        = "100 Coins"
+    description = "A small bag of coins to spend in the shop."
+  }
+
+  localization {
+    locale      = "fr-FR"
+    name        = "100 pièces"
+    description = "Un petit sac de pièces à dépenser dans la boutique."
+  }
+}
@@ -0,0 +1,41 @@
+data "appstoreconnect_price_point" "coins_100_usa" {
+  in_app_purchase_id = appstoreconnect_in_app_purchase.coins_100.id
+  territory          = "USA"
+  customer_price     = "0.99"
+}
+
+data "appstoreconnect_price_point" "coins_100_usa_sale" {
+  in_app_purchase_id = appstoreconnect_in_app_purchase.coins_100.id
+  territory          = "USA"
+  customer_price     = "1.29"
+}
+
+data "appstoreconnect_price_point" "coins_100_gbr" {
+  in_app_purchase_id = appstoreconnect_in_app_purchase.coins_100.id
+  territory          = "GBR"
+  customer_price     = "0.79"
+}
+
+resource "appstoreconnect_in_app_purchase_price_schedule" "coins_100" {
+  in_app_purchase_id = appstoreconnect_in_app_purchase.coins_100.id
+  base_territory     = "USA"
+
+  # Equalized across every territory.
+  price {
+    territory      = "USA"
+    price_point_id = data.appstoreconnect_price_point.coins_100_usa.id
+  }
+
+  # A scheduled price rise.
+  price {
+    territory      = "USA"
+    price_point_id = data.appstoreconnect_price_point.coins_100_usa_sale.id
+    start_date     = "2026-01-01"
+  }
+
+  # Overrides the equalized price in the UK.
+  price {
+    territory      = "GBR"
+    price_point_id = data.appstoreconnect_price_point.coins_100_gbr.id
+  }
+}
@@ -0,0 +1,6 @@
+resource "appstoreconnect_phased_release" "example" {
+  app_store_version_id = "6a7b8c9d-1234-5678-9abc-def012345678"
+
+  # Set to "PAUSED" to halt the rollout during an incident.
+  state = "ACTIVE"
+}
@@ -0,0 +1,13 @@
+resource "appstoreconnect_review_submission" "release" {
+  app_id   = "1234567890"
+  platform = "IOS"
+
+  items = [
+    {
+      type        = "APP_STORE_VERSION"
+      resource_id = "6a7b8c9d-1234-5678-9abc-def012345678"
+    },
+  ]
+
+  submitted = true
+}
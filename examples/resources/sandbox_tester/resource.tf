@@ -0,0 +1,10 @@
+resource "appstoreconnect_sandbox_tester" "uk_payments" {
+  email = "payments-uk@example.com"
+
+  territory                 = "GBR"
+  interrupt_purchases       = true
+  subscription_renewal_rate = "MONTHLY_RENEWAL_EVERY_FIVE_MINUTES"
+
+  # Increment to clear the tester's purchase history.
+  clear_purchase_history_version = 1
+}
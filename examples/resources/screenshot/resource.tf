@@ -0,0 +1,5 @@
+resource "appstoreconnect_screenshot" "home" {
+  screenshot_set_id = appstoreconnect_screenshot_set.iphone_67.id
+  file_path         = "${path.module}/screenshots/en-GB/iphone_67/01_home.png"
+  position          = 1
+}
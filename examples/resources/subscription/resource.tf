@@ -0,0 +1,29 @@
+resource "appstoreconnect_subscription" "pro_monthly" {
+  subscription_group_id = appstoreconnect_subscription_group.pro.id
+  product_id            = "com.example.app.pro.monthly"
+  reference_name        = "Pro Monthly"
+  duration              = "ONE_MONTH"
+  group_level           = 2
+  family_sharable       = true
+
+  localization {
+    locale      = "en-GB"
+    name        = "Pro Monthly"
+    description = "Unlock every feature, billed monthly."
+  }
+}
+
+resource "appstoreconnect_subscription" "pro_yearly" {
+  subscription_group_id = appstoreconnect_subscription_group.pro.id
+  product_id            = "com.example.app.pro.yearly"
+  reference_name        = "Pro Yearly"
+  duration              = "ONE_YEAR"
+  group_level           = 1
+  family_sharable       = true
+
+  localization {
+    locale      = "en-GB"
+    name        = "Pro Yearly"
+    description = "Unlock every feature, billed yearly."
+  }
+}
@@ -0,0 +1,15 @@
+resource "appstoreconnect_subscription_group" "pro" {
+  app_id         = "1234567890"
+  reference_name = "Pro"
+
+  localization {
+    locale = "en-GB"
+    name   = "Example Pro"
+  }
+
+  localization {
+    locale          = "fr-FR"
+    name            = "Example Pro"
+    custom_app_name = "Exemple"
+  }
+}
@@ -0,0 +1,19 @@
+# A one-week free trial for new subscribers in the UK.
+resource "appstoreconnect_subscription_introductory_offer" "pro_monthly_trial_gbr" {
+  subscription_id = appstoreconnect_subscription.pro_monthly.id
+  territory       = "GBR"
+  offer_mode      = "FREE_TRIAL"
+  duration        = "ONE_WEEK"
+}
+
+# Three discounted months for new subscribers in the US.
+resource "appstoreconnect_subscription_introductory_offer" "pro_monthly_intro_usa" {
+  subscription_id   = appstoreconnect_subscription.pro_monthly.id
+  territory         = "USA"
+  offer_mode        = "PAY_AS_YOU_GO"
+  duration          = "ONE_MONTH"
+  number_of_periods = 3
+  price_point_id    = data.appstoreconnect_price_point.pro_monthly_usa_intro.id
+  start_date        = "2026-01-01"
+  end_date          = "2026-03-31"
+}
@@ -0,0 +1,19 @@
+resource "appstoreconnect_subscription_offer_code" "pro_monthly_spring_sale" {
+  subscription_id        = appstoreconnect_subscription.pro_monthly.id
+  name                   = "Spring Sale"
+  customer_eligibilities = ["NEW", "LAPSED"]
+  offer_eligibility      = "STACK_WITH_INTRO_OFFERS"
+  offer_mode             = "PAY_AS_YOU_GO"
+  duration               = "ONE_MONTH"
+  number_of_periods      = 3
+
+  price {
+    territory      = "USA"
+    price_point_id = data.appstoreconnect_price_point.pro_monthly_usa_spring_sale.id
+  }
+
+  price {
+    territory      = "GBR"
+    price_point_id = data.appstoreconnect_price_point.pro_monthly_gbr_spring_sale.id
+  }
+}
@@ -0,0 +1,6 @@
+resource "appstoreconnect_subscription_offer_code_custom_code" "spring_sale" {
+  offer_code_id   = appstoreconnect_subscription_offer_code.pro_monthly_spring_sale.id
+  custom_code     = "SPRING2026"
+  number_of_codes = 10000
+  expiration_date = "2026-06-30"
+}
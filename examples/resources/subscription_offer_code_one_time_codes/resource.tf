@@ -0,0 +1,5 @@
+resource "appstoreconnect_subscription_offer_code_one_time_codes" "spring_sale_press" {
+  offer_code_id   = appstoreconnect_subscription_offer_code.pro_monthly_spring_sale.id
+  number_of_codes = 500
+  expiration_date = "2026-06-30"
+}
@@ -0,0 +1,27 @@
+data "appstoreconnect_price_point" "pro_monthly_usa" {
+  subscription_id = appstoreconnect_subscription.pro_monthly.id
+  territory       = "USA"
+  customer_price  = "4.99"
+}
+
+data "appstoreconnect_price_point" "pro_monthly_gbr" {
+  subscription_id = appstoreconnect_subscription.pro_monthly.id
+  territory       = "GBR"
+  customer_price  = "3.99"
+}
+
+resource "appstoreconnect_subscription_price_schedule" "pro_monthly" {
+  subscription_id        = appstoreconnect_subscription.pro_monthly.id
+  base_territory         = "USA"
+  preserve_current_price = true
+
+  price {
+    territory      = "USA"
+    price_point_id = data.appstoreconnect_price_point.pro_monthly_usa.id
+  }
+
+  price {
+    territory      = "GBR"
+    price_point_id = data.appstoreconnect_price_point.pro_monthly_gbr.id
+  }
+}
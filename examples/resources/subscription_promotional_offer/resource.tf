@@ -0,0 +1,17 @@
+resource "appstoreconnect_subscription_promotional_offer" "pro_monthly_retention" {
+  subscription_id = appstoreconnect_subscription.pro_monthly.id
+  name            = "Retention - Half Price"
+  offer_code      = "retention_half_price"
+  offer_mode      = "PAY_UP_FRONT"
+  duration        = "THREE_MONTHS"
+
+  price {
+    territory      = "USA"
+    price_point_id = data.appstoreconnect_price_point.pro_monthly_usa_retention.id
+  }
+
+  price {
+    territory      = "GBR"
+    price_point_id = data.appstoreconnect_price_point.pro_monthly_gbr_retention.id
+  }
+}
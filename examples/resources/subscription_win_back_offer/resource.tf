@@ -0,0 +1,15 @@
+resource "appstoreconnect_subscription_win_back_offer" "pro_monthly_come_back" {
+  subscription_id = appstoreconnect_subscription.pro_monthly.id
+  reference_name  = "Come Back - Free Month"
+  offer_id        = "come_back_free_month"
+  offer_mode      = "FREE_TRIAL"
+  duration        = "ONE_MONTH"
+
+  paid_subscription_duration_months     = 3
+  time_since_last_subscribed_min_months = 2
+  time_since_last_subscribed_max_months = 12
+  wait_between_offers_months            = 6
+
+  start_date = "2026-01-01"
+  priority   = "HIGH"
+}
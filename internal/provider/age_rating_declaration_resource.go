@@ -0,0 +1,384 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/appinfo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AgeRatingDeclarationResource{}
+var _ resource.ResourceWithImportState = &AgeRatingDeclarationResource{}
+var _ resource.ResourceWithValidateConfig = &AgeRatingDeclarationResource{}
+
+// ageRatingFrequencies are the answers to questions about how often a kind of
+// content appears in the app.
+var ageRatingFrequencies = []string{"NONE", "INFREQUENT_OR_MILD", "FREQUENT_OR_INTENSE"}
+
+// ageRatingKidsAgeBands are the age bands an app in the Kids category can target.
+var ageRatingKidsAgeBands = []string{"FIVE_AND_UNDER", "SIX_TO_EIGHT", "NINE_TO_ELEVEN"}
+
+// ageRatingOverrides are the ratings an app can choose above its calculated rating.
+var ageRatingOverrides = []string{"NONE", "NINE_PLUS", "THIRTEEN_PLUS", "SIXTEEN_PLUS", "EIGHTEEN_PLUS", "UNRATED"}
+
+// ageRatingKoreaOverrides are the ratings an app can choose in South Korea.
+var ageRatingKoreaOverrides = []string{"NONE", "FIFTEEN_PLUS", "NINETEEN_PLUS"}
+
+type ageRatingDeclarationClient interface {
+	GetAgeRatingDeclaration(ctx context.Context, appInfoID string) (*appinfo.AgeRatingDeclaration, error)
+	ModifyAgeRatingDeclaration(ctx context.Context, id string, declaration appinfo.AgeRatingDeclaration) (*appinfo.AgeRatingDeclaration, error)
+}
+
+func NewAgeRatingDeclarationResource() resource.Resource {
+	return &AgeRatingDeclarationResource{}
+}
+
+// AgeRatingDeclarationResource defines the resource implementation.
+type AgeRatingDeclarationResource struct {
+	client ageRatingDeclarationClient
+}
+
+// AgeRatingDeclarationResourceModel describes the resource data model.
+type AgeRatingDeclarationResourceModel struct {
+	ID                                          types.String `tfsdk:"id"`
+	AppInfoID                                   types.String `tfsdk:"app_info_id"`
+	AlcoholTobaccoOrDrugUseOrReferences         types.String `tfsdk:"alcohol_tobacco_or_drug_use_or_references"`
+	Contests                                    types.String `tfsdk:"contests"`
+	GamblingSimulated                           types.String `tfsdk:"gambling_simulated"`
+	GunsOrOtherWeapons                          types.String `tfsdk:"guns_or_other_weapons"`
+	HorrorOrFearThemes                          types.String `tfsdk:"horror_or_fear_themes"`
+	MatureOrSuggestiveThemes                    types.String `tfsdk:"mature_or_suggestive_themes"`
+	MedicalOrTreatmentInformation               types.String `tfsdk:"medical_or_treatment_information"`
+	ProfanityOrCrudeHumor                       types.String `tfsdk:"profanity_or_crude_humor"`
+	SexualContentGraphicAndNudity               types.String `tfsdk:"sexual_content_graphic_and_nudity"`
+	SexualContentOrNudity                       types.String `tfsdk:"sexual_content_or_nudity"`
+	ViolenceCartoonOrFantasy                    types.String `tfsdk:"violence_cartoon_or_fantasy"`
+	ViolenceRealistic                           types.String `tfsdk:"violence_realistic"`
+	ViolenceRealisticProlongedGraphicOrSadistic types.String `tfsdk:"violence_realistic_prolonged_graphic_or_sadistic"`
+	Advertising                                 types.Bool   `tfsdk:"advertising"`
+	AgeAssurance                                types.Bool   `tfsdk:"age_assurance"`
+	Gambling                                    types.Bool   `tfsdk:"gambling"`
+	HealthOrWellnessTopics                      types.Bool   `tfsdk:"health_or_wellness_topics"`
+	LootBox                                     types.Bool   `tfsdk:"loot_box"`
+	MessagingAndChat                            types.Bool   `tfsdk:"messaging_and_chat"`
+	ParentalControls                            types.Bool   `tfsdk:"parental_controls"`
+	UnrestrictedWebAccess                       types.Bool   `tfsdk:"unrestricted_web_access"`
+	UserGeneratedContent                        types.Bool   `tfsdk:"user_generated_content"`
+	KidsAgeBand                                 types.String `tfsdk:"kids_age_band"`
+	AgeRatingOverride                           types.String `tfsdk:"age_rating_override"`
+	KoreaAgeRatingOverride                      types.String `tfsdk:"korea_age_rating_override"`
+	DeveloperAgeRatingInfoURL                   types.String `tfsdk:"developer_age_rating_info_url"`
+	AgeRating                                   types.String `tfsdk:"age_rating"`
+}
+
+func (r *AgeRatingDeclarationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_age_rating_declaration"
+}
+
+// ageRatingFrequencyAttribute describes a question about how often the app
+// contains a kind of content. Unanswered questions default to `NONE`.
+func ageRatingFrequencyAttribute(content string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("NONE"),
+		MarkdownDescription: fmt.Sprintf("How often the app contains %s: `NONE`, `INFREQUENT_OR_MILD` or `FREQUENT_OR_INTENSE`. Defaults to `NONE`.", content),
+	}
+}
+
+// ageRatingFeatureAttribute describes a question about whether the app has a
+// feature. Unanswered questions default to `false`.
+func ageRatingFeatureAttribute(feature string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: fmt.Sprintf("Whether the app %s. Defaults to `false`.", feature),
+	}
+}
+
+func (r *AgeRatingDeclarationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the age rating questionnaire of an app. Every question is managed, so answers changed in " +
+			"App Store Connect show up as drift. The declaration can't be deleted, so destroying this resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the age rating declaration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_info_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the app info the declaration belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"alcohol_tobacco_or_drug_use_or_references": ageRatingFrequencyAttribute("alcohol, tobacco or drug use, or references to them"),
+			"contests":                                         ageRatingFrequencyAttribute("contests"),
+			"gambling_simulated":                               ageRatingFrequencyAttribute("simulated gambling"),
+			"guns_or_other_weapons":                            ageRatingFrequencyAttribute("guns or other weapons"),
+			"horror_or_fear_themes":                            ageRatingFrequencyAttribute("horror or fear themes"),
+			"mature_or_suggestive_themes":                      ageRatingFrequencyAttribute("mature or suggestive themes"),
+			"medical_or_treatment_information":                 ageRatingFrequencyAttribute("medical or treatment information"),
+			"profanity_or_crude_humor":                         ageRatingFrequencyAttribute("profanity or crude humor"),
+			"sexual_content_graphic_and_nudity":                ageRatingFrequencyAttribute("graphic sexual content and nudity"),
+			"sexual_content_or_nudity":                         ageRatingFrequencyAttribute("sexual content or nudity"),
+			"violence_cartoon_or_fantasy":                      ageRatingFrequencyAttribute("cartoon or fantasy violence"),
+			"violence_realistic":                               ageRatingFrequencyAttribute("realistic violence"),
+			"violence_realistic_prolonged_graphic_or_sadistic": ageRatingFrequencyAttribute("prolonged graphic or sadistic realistic violence"),
+			"advertising":                                      ageRatingFeatureAttribute("shows advertising"),
+			"age_assurance":                                    ageRatingFeatureAttribute("verifies the age of its users"),
+			"gambling":                                         ageRatingFeatureAttribute("offers gambling with real money"),
+			"health_or_wellness_topics":                        ageRatingFeatureAttribute("covers health or wellness topics"),
+			"loot_box":                                         ageRatingFeatureAttribute("sells loot boxes"),
+			"messaging_and_chat":                               ageRatingFeatureAttribute("lets users message or chat with each other"),
+			"parental_controls":                                ageRatingFeatureAttribute("has parental controls"),
+			"unrestricted_web_access":                          ageRatingFeatureAttribute("gives unrestricted access to the web"),
+			"user_generated_content":                           ageRatingFeatureAttribute("shows content created by other users"),
+			"kids_age_band": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The age band the app targets in the Kids category: `FIVE_AND_UNDER`, `SIX_TO_EIGHT` or `NINE_TO_ELEVEN`. Omit for apps outside the Kids category.",
+			},
+			"age_rating_override": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("NONE"),
+				MarkdownDescription: "A rating higher than the calculated one: `NONE`, `NINE_PLUS`, `THIRTEEN_PLUS`, `SIXTEEN_PLUS`, `EIGHTEEN_PLUS` or `UNRATED`. Defaults to `NONE`.",
+			},
+			"korea_age_rating_override": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("NONE"),
+				MarkdownDescription: "A rating higher than the calculated one in South Korea: `NONE`, `FIFTEEN_PLUS` or `NINETEEN_PLUS`. Defaults to `NONE`.",
+			},
+			"developer_age_rating_info_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A web page with more information about the app's age rating.",
+			},
+			"age_rating": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The App Store age rating that results from the declaration, e.g. `FOUR_PLUS` or `THIRTEEN_PLUS`.",
+			},
+		},
+	}
+}
+
+func (r *AgeRatingDeclarationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ageRatingDeclarationClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected ageRatingDeclarationClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r AgeRatingDeclarationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AgeRatingDeclarationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, answer := range []struct {
+		attribute string
+		value     types.String
+		allowed   []string
+	}{
+		{"alcohol_tobacco_or_drug_use_or_references", data.AlcoholTobaccoOrDrugUseOrReferences, ageRatingFrequencies},
+		{"contests", data.Contests, ageRatingFrequencies},
+		{"gambling_simulated", data.GamblingSimulated, ageRatingFrequencies},
+		{"guns_or_other_weapons", data.GunsOrOtherWeapons, ageRatingFrequencies},
+		{"horror_or_fear_themes", data.HorrorOrFearThemes, ageRatingFrequencies},
+		{"mature_or_suggestive_themes", data.MatureOrSuggestiveThemes, ageRatingFrequencies},
+		{"medical_or_treatment_information", data.MedicalOrTreatmentInformation, ageRatingFrequencies},
+		{"profanity_or_crude_humor", data.ProfanityOrCrudeHumor, ageRatingFrequencies},
+		{"sexual_content_graphic_and_nudity", data.SexualContentGraphicAndNudity, ageRatingFrequencies},
+		{"sexual_content_or_nudity", data.SexualContentOrNudity, ageRatingFrequencies},
+		{"violence_cartoon_or_fantasy", data.ViolenceCartoonOrFantasy, ageRatingFrequencies},
+		{"violence_realistic", data.ViolenceRealistic, ageRatingFrequencies},
+		{"violence_realistic_prolonged_graphic_or_sadistic", data.ViolenceRealisticProlongedGraphicOrSadistic, ageRatingFrequencies},
+		{"kids_age_band", data.KidsAgeBand, ageRatingKidsAgeBands},
+		{"age_rating_override", data.AgeRatingOverride, ageRatingOverrides},
+		{"korea_age_rating_override", data.KoreaAgeRatingOverride, ageRatingKoreaOverrides},
+	} {
+		if answer.value.IsNull() || answer.value.IsUnknown() || slices.Contains(answer.allowed, answer.value.ValueString()) {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root(answer.attribute),
+			"Invalid Configuration",
+			fmt.Sprintf("`%s` must be one of %s, got %q.", answer.attribute, strings.Join(answer.allowed, ", "), answer.value.ValueString()),
+		)
+	}
+}
+
+// declaration converts the planned answers into a declaration for App Store Connect.
+func (data AgeRatingDeclarationResourceModel) declaration() appinfo.AgeRatingDeclaration {
+	return appinfo.AgeRatingDeclaration{
+		AppInfoID:                                   data.AppInfoID.ValueString(),
+		AlcoholTobaccoOrDrugUseOrReferences:         data.AlcoholTobaccoOrDrugUseOrReferences.ValueString(),
+		Contests:                                    data.Contests.ValueString(),
+		GamblingSimulated:                           data.GamblingSimulated.ValueString(),
+		GunsOrOtherWeapons:                          data.GunsOrOtherWeapons.ValueString(),
+		HorrorOrFearThemes:                          data.HorrorOrFearThemes.ValueString(),
+		MatureOrSuggestiveThemes:                    data.MatureOrSuggestiveThemes.ValueString(),
+		MedicalOrTreatmentInformation:               data.MedicalOrTreatmentInformation.ValueString(),
+		ProfanityOrCrudeHumor:                       data.ProfanityOrCrudeHumor.ValueString(),
+		SexualContentGraphicAndNudity:               data.SexualContentGraphicAndNudity.ValueString(),
+		SexualContentOrNudity:                       data.SexualContentOrNudity.ValueString(),
+		ViolenceCartoonOrFantasy:                    data.ViolenceCartoonOrFantasy.ValueString(),
+		ViolenceRealistic:                           data.ViolenceRealistic.ValueString(),
+		ViolenceRealisticProlongedGraphicOrSadistic: data.ViolenceRealisticProlongedGraphicOrSadistic.ValueString(),
+		Advertising:                                 data.Advertising.ValueBool(),
+		AgeAssurance:                                data.AgeAssurance.ValueBool(),
+		Gambling:                                    data.Gambling.ValueBool(),
+		HealthOrWellnessTopics:                      data.HealthOrWellnessTopics.ValueBool(),
+		LootBox:                                     data.LootBox.ValueBool(),
+		MessagingAndChat:                            data.MessagingAndChat.ValueBool(),
+		ParentalControls:                            data.ParentalControls.ValueBool(),
+		UnrestrictedWebAccess:                       data.UnrestrictedWebAccess.ValueBool(),
+		UserGeneratedContent:                        data.UserGeneratedContent.ValueBool(),
+		KidsAgeBand:                                 data.KidsAgeBand.ValueString(),
+		AgeRatingOverride:                           data.AgeRatingOverride.ValueString(),
+		KoreaAgeRatingOverride:                      data.KoreaAgeRatingOverride.ValueString(),
+		DeveloperAgeRatingInfoURL:                   data.DeveloperAgeRatingInfoURL.ValueString(),
+	}
+}
+
+func (r *AgeRatingDeclarationResource) populateState(data *AgeRatingDeclarationResourceModel, declaration *appinfo.AgeRatingDeclaration) {
+	data.ID = types.StringValue(declaration.ID)
+	data.AppInfoID = types.StringValue(declaration.AppInfoID)
+	data.AlcoholTobaccoOrDrugUseOrReferences = types.StringValue(declaration.AlcoholTobaccoOrDrugUseOrReferences)
+	data.Contests = types.StringValue(declaration.Contests)
+	data.GamblingSimulated = types.StringValue(declaration.GamblingSimulated)
+	data.GunsOrOtherWeapons = types.StringValue(declaration.GunsOrOtherWeapons)
+	data.HorrorOrFearThemes = types.StringValue(declaration.HorrorOrFearThemes)
+	data.MatureOrSuggestiveThemes = types.StringValue(declaration.MatureOrSuggestiveThemes)
+	data.MedicalOrTreatmentInformation = types.StringValue(declaration.MedicalOrTreatmentInformation)
+	data.ProfanityOrCrudeHumor = types.StringValue(declaration.ProfanityOrCrudeHumor)
+	data.SexualContentGraphicAndNudity = types.StringValue(declaration.SexualContentGraphicAndNudity)
+	data.SexualContentOrNudity = types.StringValue(declaration.SexualContentOrNudity)
+	data.ViolenceCartoonOrFantasy = types.StringValue(declaration.ViolenceCartoonOrFantasy)
+	data.ViolenceRealistic = types.StringValue(declaration.ViolenceRealistic)
+	data.ViolenceRealisticProlongedGraphicOrSadistic = types.StringValue(declaration.ViolenceRealisticProlongedGraphicOrSadistic)
+	data.Advertising = types.BoolValue(declaration.Advertising)
+	data.AgeAssurance = types.BoolValue(declaration.AgeAssurance)
+	data.Gambling = types.BoolValue(declaration.Gambling)
+	data.HealthOrWellnessTopics = types.BoolValue(declaration.HealthOrWellnessTopics)
+	data.LootBox = types.BoolValue(declaration.LootBox)
+	data.MessagingAndChat = types.BoolValue(declaration.MessagingAndChat)
+	data.ParentalControls = types.BoolValue(declaration.ParentalControls)
+	data.UnrestrictedWebAccess = types.BoolValue(declaration.UnrestrictedWebAccess)
+	data.UserGeneratedContent = types.BoolValue(declaration.UserGeneratedContent)
+	data.KidsAgeBand = optionalString(declaration.KidsAgeBand)
+	data.AgeRatingOverride = types.StringValue(declaration.AgeRatingOverride)
+	data.KoreaAgeRatingOverride = types.StringValue(declaration.KoreaAgeRatingOverride)
+	data.DeveloperAgeRatingInfoURL = optionalString(declaration.DeveloperAgeRatingInfoURL)
+	data.AgeRating = types.StringValue(declaration.AppStoreAgeRating)
+}
+
+func (r *AgeRatingDeclarationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AgeRatingDeclarationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every app info already has a declaration, so creating the resource
+	// answers the existing questionnaire.
+	existing, err := r.client.GetAgeRatingDeclaration(ctx, data.AppInfoID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read age rating declaration, got error: %s", err))
+		return
+	}
+
+	declaration, err := r.client.ModifyAgeRatingDeclaration(ctx, existing.ID, data.declaration())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify age rating declaration, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "answered an age rating declaration")
+
+	r.populateState(&data, declaration)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AgeRatingDeclarationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AgeRatingDeclarationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	declaration, err := r.client.GetAgeRatingDeclaration(ctx, data.AppInfoID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read age rating declaration, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, declaration)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AgeRatingDeclarationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AgeRatingDeclarationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	declaration, err := r.client.ModifyAgeRatingDeclaration(ctx, data.ID.ValueString(), data.declaration())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify age rating declaration, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified an age rating declaration")
+
+	r.populateState(&data, declaration)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AgeRatingDeclarationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Age rating declarations cannot be deleted, so they are only removed from state.
+	tflog.Trace(ctx, "removed age rating declaration from state")
+}
+
+func (r *AgeRatingDeclarationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("app_info_id"), req, resp)
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/appinfo"
+)
+
+type mockAgeRatingDeclarationClient struct {
+	modifiedID string
+	modified   *appinfo.AgeRatingDeclaration
+}
+
+func (m *mockAgeRatingDeclarationClient) GetAgeRatingDeclaration(ctx context.Context, appInfoID string) (*appinfo.AgeRatingDeclaration, error) {
+	return &appinfo.AgeRatingDeclaration{ID: "declaration-id", AppInfoID: appInfoID, AppStoreAgeRating: "FOUR_PLUS"}, nil
+}
+
+func (m *mockAgeRatingDeclarationClient) ModifyAgeRatingDeclaration(ctx context.Context, id string, declaration appinfo.AgeRatingDeclaration) (*appinfo.AgeRatingDeclaration, error) {
+	sent := declaration
+	m.modifiedID = id
+	m.modified = &sent
+
+	declaration.ID = id
+	declaration.AppStoreAgeRating = "FOUR_PLUS"
+	if declaration.ViolenceRealistic == "FREQUENT_OR_INTENSE" {
+		declaration.AppStoreAgeRating = "SIXTEEN_PLUS"
+	}
+	return &declaration, nil
+}
+
+func ageRatingDeclarationResourceSchema() schema.Schema {
+	r := &AgeRatingDeclarationResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+// ageRatingDeclarationVal answers every question with its default, except
+// for the answers given.
+func ageRatingDeclarationVal(s schema.Schema, answers map[string]tftypes.Value) tftypes.Value {
+	values := map[string]tftypes.Value{
+		"id":                            tftypes.NewValue(tftypes.String, nil),
+		"app_info_id":                   tftypes.NewValue(tftypes.String, "app-info-id"),
+		"kids_age_band":                 tftypes.NewValue(tftypes.String, nil),
+		"age_rating_override":           tftypes.NewValue(tftypes.String, "NONE"),
+		"korea_age_rating_override":     tftypes.NewValue(tftypes.String, "NONE"),
+		"developer_age_rating_info_url": tftypes.NewValue(tftypes.String, nil),
+		"age_rating":                    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	}
+	for name, attribute := range s.Attributes {
+		if _, ok := values[name]; ok {
+			continue
+		}
+		switch attribute.(type) {
+		case schema.StringAttribute:
+			values[name] = tftypes.NewValue(tftypes.String, "NONE")
+		case schema.BoolAttribute:
+			values[name] = tftypes.NewValue(tftypes.Bool, false)
+		}
+	}
+	for name, value := range answers {
+		values[name] = value
+	}
+
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), values)
+}
+
+func TestAgeRatingDeclarationResource_Create_AnswersExistingDeclaration(t *testing.T) {
+	client := &mockAgeRatingDeclarationClient{}
+	r := &AgeRatingDeclarationResource{client: client}
+
+	s := ageRatingDeclarationResourceSchema()
+	planVal := ageRatingDeclarationVal(s, map[string]tftypes.Value{
+		"violence_realistic": tftypes.NewValue(tftypes.String, "FREQUENT_OR_INTENSE"),
+		"messaging_and_chat": tftypes.NewValue(tftypes.Bool, true),
+	})
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if client.modifiedID != "declaration-id" {
+		t.Errorf("expected the existing declaration to be modified, got %q", client.modifiedID)
+	}
+	if client.modified.ViolenceRealistic != "FREQUENT_OR_INTENSE" || !client.modified.MessagingAndChat {
+		t.Errorf("expected answers to be sent, got %+v", client.modified)
+	}
+	if client.modified.Contests != "NONE" {
+		t.Errorf("expected unanswered questions to be sent as NONE, got %q", client.modified.Contests)
+	}
+
+	var data AgeRatingDeclarationResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "declaration-id" {
+		t.Errorf("expected ID 'declaration-id', got %q", data.ID.ValueString())
+	}
+	if data.AgeRating.ValueString() != "SIXTEEN_PLUS" {
+		t.Errorf("expected age rating 'SIXTEEN_PLUS', got %q", data.AgeRating.ValueString())
+	}
+}
+
+func TestAgeRatingDeclarationResource_ValidateConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		answers     map[string]tftypes.Value
+		expectError bool
+	}{
+		{name: "defaults"},
+		{name: "valid answers", answers: map[string]tftypes.Value{
+			"horror_or_fear_themes": tftypes.NewValue(tftypes.String, "INFREQUENT_OR_MILD"),
+			"kids_age_band":         tftypes.NewValue(tftypes.String, "SIX_TO_EIGHT"),
+			"age_rating_override":   tftypes.NewValue(tftypes.String, "THIRTEEN_PLUS"),
+		}},
+		{name: "invalid frequency", answers: map[string]tftypes.Value{
+			"contests": tftypes.NewValue(tftypes.String, "SOMETIMES"),
+		}, expectError: true},
+		{name: "invalid kids age band", answers: map[string]tftypes.Value{
+			"kids_age_band": tftypes.NewValue(tftypes.String, "TWELVE_AND_OVER"),
+		}, expectError: true},
+		{name: "invalid korea override", answers: map[string]tftypes.Value{
+			"korea_age_rating_override": tftypes.NewValue(tftypes.String, "EIGHTEEN_PLUS"),
+		}, expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := AgeRatingDeclarationResource{}
+
+			s := ageRatingDeclarationResourceSchema()
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: ageRatingDeclarationVal(s, tc.answers)},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error=%t, got diagnostics %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
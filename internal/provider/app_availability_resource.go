@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/availability"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AppAvailabilityResource{}
+var _ resource.ResourceWithImportState = &AppAvailabilityResource{}
+var _ resource.ResourceWithValidateConfig = &AppAvailabilityResource{}
+
+type appAvailabilityClient interface {
+	GetAppAvailability(ctx context.Context, appID string) (*availability.AppAvailability, error)
+	SetAppAvailability(ctx context.Context, availability availability.AppAvailability) (*availability.AppAvailability, error)
+}
+
+func NewAppAvailabilityResource() resource.Resource {
+	return &AppAvailabilityResource{}
+}
+
+// AppAvailabilityResource defines the resource implementation.
+type AppAvailabilityResource struct {
+	client appAvailabilityClient
+}
+
+// AppAvailabilityResourceModel describes the resource data model.
+type AppAvailabilityResourceModel struct {
+	ID                        types.String `tfsdk:"id"`
+	AppID                     types.String `tfsdk:"app_id"`
+	Territories               types.Set    `tfsdk:"territories"`
+	AvailableInNewTerritories types.Bool   `tfsdk:"available_in_new_territories"`
+}
+
+func (r *AppAvailabilityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_availability"
+}
+
+func (r *AppAvailabilityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manages the territories an app is available in. The app is removed from sale " +
+			"in any territory not listed. Availability can't be deleted, so destroying this resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the app.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the app whose availability is managed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"territories": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The three-letter codes of every territory the app is available in, e.g. `GBR`.",
+			},
+			"available_in_new_territories": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the app is automatically made available in territories the App Store launches in later.",
+			},
+		},
+	}
+}
+
+func (r *AppAvailabilityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(appAvailabilityClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected appAvailabilityClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r AppAvailabilityResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AppAvailabilityResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Territories.IsNull() || data.Territories.IsUnknown() {
+		return
+	}
+
+	var territories []types.String
+	resp.Diagnostics.Append(data.Territories.ElementsAs(ctx, &territories, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(territories) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("territories"),
+			"Invalid Configuration",
+			"At least one territory is required.",
+		)
+	}
+	for _, territory := range territories {
+		if !territory.IsUnknown() && !isTerritoryCode(territory.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("territories"),
+				"Invalid Configuration",
+				fmt.Sprintf("`territories` must only contain three-letter territory codes such as `GBR`, got %q.", territory.ValueString()),
+			)
+		}
+	}
+}
+
+// isTerritoryCode reports whether code looks like an App Store territory
+// code, which are three upper-case letters.
+func isTerritoryCode(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, c := range code {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *AppAvailabilityResource) populateState(ctx context.Context, data *AppAvailabilityResourceModel, appAvailability *availability.AppAvailability) diag.Diagnostics {
+	data.ID = types.StringValue(appAvailability.AppID)
+	data.AppID = types.StringValue(appAvailability.AppID)
+	data.AvailableInNewTerritories = types.BoolValue(appAvailability.AvailableInNewTerritories)
+
+	territories := slices.Clone(appAvailability.Territories)
+	slices.Sort(territories)
+
+	var diags diag.Diagnostics
+	data.Territories, diags = types.SetValueFrom(ctx, types.StringType, territories)
+	return diags
+}
+
+// setAvailability replaces the app's availability with the one in data, then
+// records the result in data.
+func (r *AppAvailabilityResource) setAvailability(ctx context.Context, data *AppAvailabilityResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	territories := []string{}
+	diags.Append(data.Territories.ElementsAs(ctx, &territories, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	updated, err := r.client.SetAppAvailability(ctx, availability.AppAvailability{
+		AppID:                     data.AppID.ValueString(),
+		AvailableInNewTerritories: data.AvailableInNewTerritories.ValueBool(),
+		Territories:               territories,
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to set app availability, got error: %s", err))
+		return diags
+	}
+
+	diags.Append(r.populateState(ctx, data, updated)...)
+	return diags
+}
+
+func (r *AppAvailabilityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AppAvailabilityResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setAvailability(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "set app availability")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppAvailabilityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppAvailabilityResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appAvailability, err := r.client.GetAppAvailability(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app availability, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, appAvailability)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppAvailabilityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AppAvailabilityResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setAvailability(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated app availability")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppAvailabilityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// App availability cannot be deleted, so it is only removed from state.
+	tflog.Trace(ctx, "removed app availability from state")
+}
+
+func (r *AppAvailabilityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
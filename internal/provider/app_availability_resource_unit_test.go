@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/availability"
+)
+
+type mockAppAvailabilityClient struct {
+	set *availability.AppAvailability
+}
+
+func (m *mockAppAvailabilityClient) GetAppAvailability(ctx context.Context, appID string) (*availability.AppAvailability, error) {
+	return m.set, nil
+}
+
+func (m *mockAppAvailabilityClient) SetAppAvailability(ctx context.Context, appAvailability availability.AppAvailability) (*availability.AppAvailability, error) {
+	m.set = &appAvailability
+	appAvailability.ID = "availability-id"
+	return &appAvailability, nil
+}
+
+func appAvailabilityResourceSchema() schema.Schema {
+	r := &AppAvailabilityResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func appAvailabilityVal(s schema.Schema, territories ...string) tftypes.Value {
+	values := make([]tftypes.Value, len(territories))
+	for i, territory := range territories {
+		values[i] = tftypes.NewValue(tftypes.String, territory)
+	}
+
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                           tftypes.NewValue(tftypes.String, nil),
+		"app_id":                       tftypes.NewValue(tftypes.String, "app-id"),
+		"territories":                  tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, values),
+		"available_in_new_territories": tftypes.NewValue(tftypes.Bool, false),
+	})
+}
+
+func TestAppAvailabilityResource_Create_SetsAvailability(t *testing.T) {
+	client := &mockAppAvailabilityClient{}
+	r := &AppAvailabilityResource{client: client}
+
+	s := appAvailabilityResourceSchema()
+	planVal := appAvailabilityVal(s, "GBR", "FRA", "DEU")
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.set.Territories) != 3 {
+		t.Errorf("expected 3 territories to be sent, got %v", client.set.Territories)
+	}
+	if client.set.AvailableInNewTerritories {
+		t.Error("expected the app not to be made available in new territories")
+	}
+
+	var data AppAvailabilityResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "app-id" {
+		t.Errorf("expected ID 'app-id', got %q", data.ID.ValueString())
+	}
+	if len(data.Territories.Elements()) != 3 {
+		t.Errorf("expected 3 territories in state, got %d", len(data.Territories.Elements()))
+	}
+}
+
+func TestAppAvailabilityResource_ValidateConfig(t *testing.T) {
+	tests := map[string]struct {
+		territories []string
+		expectError bool
+	}{
+		"valid":       {territories: []string{"GBR", "FRA"}},
+		"empty":       {territories: []string{}, expectError: true},
+		"two letters": {territories: []string{"GB"}, expectError: true},
+		"lower case":  {territories: []string{"gbr"}, expectError: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := AppAvailabilityResource{}
+
+			s := appAvailabilityResourceSchema()
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: appAvailabilityVal(s, tt.territories...)},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Errorf("expected error: %v, got diagnostics: %v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
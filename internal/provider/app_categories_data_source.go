@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/oliver-binns/appstore-go/appinfo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AppCategoriesDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &AppCategoriesDataSource{}
+
+type appCategoriesClient interface {
+	ListAppCategories(ctx context.Context) ([]appinfo.Category, error)
+}
+
+func NewAppCategoriesDataSource() datasource.DataSource {
+	return &AppCategoriesDataSource{}
+}
+
+// AppCategoriesDataSource defines the data source implementation.
+type AppCategoriesDataSource struct {
+	client appCategoriesClient
+}
+
+// AppCategoriesDataSourceModel describes the data source data model.
+type AppCategoriesDataSourceModel struct {
+	Platform   types.String `tfsdk:"platform"`
+	Categories types.List   `tfsdk:"categories"`
+}
+
+type appCategoryModel struct {
+	ID            types.String `tfsdk:"id"`
+	Platforms     types.List   `tfsdk:"platforms"`
+	Subcategories types.List   `tfsdk:"subcategories"`
+}
+
+type appSubcategoryModel struct {
+	ID        types.String `tfsdk:"id"`
+	Platforms types.List   `tfsdk:"platforms"`
+}
+
+var appSubcategoryAttrTypes = map[string]attr.Type{
+	"id":        types.StringType,
+	"platforms": types.ListType{ElemType: types.StringType},
+}
+
+var appCategoryAttrTypes = map[string]attr.Type{
+	"id":            types.StringType,
+	"platforms":     types.ListType{ElemType: types.StringType},
+	"subcategories": types.ListType{ElemType: types.ObjectType{AttrTypes: appSubcategoryAttrTypes}},
+}
+
+func (d *AppCategoriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_categories"
+}
+
+func (d *AppCategoriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the categories and subcategories an app can be placed in on the App Store.",
+		Attributes: map[string]schema.Attribute{
+			"platform": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list categories available on this platform: `IOS`, `MAC_OS`, `TV_OS` or `VISION_OS`.",
+			},
+			"categories": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The top-level categories, ordered by identifier.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The identifier of the category, e.g. `GAMES`.",
+						},
+						"platforms": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The platforms the category is available on.",
+						},
+						"subcategories": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The subcategories of the category, ordered by identifier.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The identifier of the subcategory, e.g. `GAMES_PUZZLE`.",
+									},
+									"platforms": schema.ListAttribute{
+										Computed:            true,
+										ElementType:         types.StringType,
+										MarkdownDescription: "The platforms the subcategory is available on.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AppCategoriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(appCategoriesClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected appCategoriesClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d AppCategoriesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data AppCategoriesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Platform.IsNull() || data.Platform.IsUnknown() {
+		return
+	}
+
+	if !slices.Contains(buildPlatforms, data.Platform.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("platform"),
+			"Invalid Platform",
+			fmt.Sprintf("`platform` must be one of %s, got %q.", strings.Join(buildPlatforms, ", "), data.Platform.ValueString()),
+		)
+	}
+}
+
+// availableOn reports whether category is available on platform, treating
+// an empty platform as every platform.
+func availableOn(category appinfo.Category, platform string) bool {
+	return platform == "" || slices.Contains(category.Platforms, platform)
+}
+
+// sortedCategories returns the categories available on platform, ordered by
+// identifier.
+func sortedCategories(categories []appinfo.Category, platform string) []appinfo.Category {
+	filtered := []appinfo.Category{}
+	for _, category := range categories {
+		if availableOn(category, platform) {
+			filtered = append(filtered, category)
+		}
+	}
+
+	slices.SortFunc(filtered, func(a, b appinfo.Category) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return filtered
+}
+
+func appCategoryValue(ctx context.Context, category appinfo.Category, platform string) (appCategoryModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	platforms, d := types.ListValueFrom(ctx, types.StringType, category.Platforms)
+	diags.Append(d...)
+
+	subcategories := []appSubcategoryModel{}
+	for _, subcategory := range sortedCategories(category.Subcategories, platform) {
+		subcategoryPlatforms, d := types.ListValueFrom(ctx, types.StringType, subcategory.Platforms)
+		diags.Append(d...)
+
+		subcategories = append(subcategories, appSubcategoryModel{
+			ID:        types.StringValue(subcategory.ID),
+			Platforms: subcategoryPlatforms,
+		})
+	}
+
+	subcategoriesValue, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: appSubcategoryAttrTypes}, subcategories)
+	diags.Append(d...)
+
+	return appCategoryModel{
+		ID:            types.StringValue(category.ID),
+		Platforms:     platforms,
+		Subcategories: subcategoriesValue,
+	}, diags
+}
+
+func (d *AppCategoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AppCategoriesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	categories, err := d.client.ListAppCategories(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list app categories, got error: %s", err))
+		return
+	}
+
+	platform := data.Platform.ValueString()
+
+	models := []appCategoryModel{}
+	for _, category := range sortedCategories(categories, platform) {
+		model, diags := appCategoryValue(ctx, category, platform)
+		resp.Diagnostics.Append(diags...)
+		models = append(models, model)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: appCategoryAttrTypes}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Categories = value
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/appinfo"
+)
+
+type mockAppCategoriesClient struct{}
+
+func (m *mockAppCategoriesClient) ListAppCategories(ctx context.Context) ([]appinfo.Category, error) {
+	return []appinfo.Category{
+		{
+			ID:        "STICKERS",
+			Platforms: []string{"IOS"},
+		},
+		{
+			ID:        "GAMES",
+			Platforms: []string{"IOS", "MAC_OS"},
+			Subcategories: []appinfo.Category{
+				{ID: "GAMES_WORD", Platforms: []string{"IOS", "MAC_OS"}},
+				{ID: "GAMES_CARD", Platforms: []string{"IOS"}},
+				{ID: "GAMES_PUZZLE", Platforms: []string{"IOS", "MAC_OS"}},
+			},
+		},
+		{
+			ID:        "DEVELOPER_TOOLS",
+			Platforms: []string{"IOS", "MAC_OS"},
+		},
+	}, nil
+}
+
+func TestAppCategoriesDataSource_Read_FiltersByPlatform(t *testing.T) {
+	d := &AppCategoriesDataSource{client: &mockAppCategoriesClient{}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	configVal := tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"platform": tftypes.NewValue(tftypes.String, "MAC_OS"),
+		"categories": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"id":        tftypes.String,
+			"platforms": tftypes.List{ElementType: tftypes.String},
+			"subcategories": tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"id":        tftypes.String,
+				"platforms": tftypes.List{ElementType: tftypes.String},
+			}}},
+		}}}, nil),
+	})
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: s, Raw: configVal},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: configVal},
+	}
+
+	d.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+
+	var data AppCategoriesDataSourceModel
+	resp.State.Get(context.Background(), &data)
+
+	var categories []appCategoryModel
+	data.Categories.ElementsAs(context.Background(), &categories, false)
+
+	if len(categories) != 2 || categories[0].ID.ValueString() != "DEVELOPER_TOOLS" || categories[1].ID.ValueString() != "GAMES" {
+		t.Fatalf("expected DEVELOPER_TOOLS and GAMES, got %v", categories)
+	}
+
+	var subcategories []appSubcategoryModel
+	categories[1].Subcategories.ElementsAs(context.Background(), &subcategories, false)
+
+	if len(subcategories) != 2 || subcategories[0].ID.ValueString() != "GAMES_PUZZLE" || subcategories[1].ID.ValueString() != "GAMES_WORD" {
+		t.Errorf("expected GAMES_PUZZLE and GAMES_WORD, got %v", subcategories)
+	}
+}
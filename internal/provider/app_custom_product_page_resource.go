@@ -0,0 +1,560 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/productpages"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AppCustomProductPageResource{}
+var _ resource.ResourceWithImportState = &AppCustomProductPageResource{}
+var _ resource.ResourceWithValidateConfig = &AppCustomProductPageResource{}
+var _ resource.ResourceWithModifyPlan = &AppCustomProductPageResource{}
+
+// editableProductPageVersionStates are the version states in which Apple
+// accepts changes. Any other state needs a new version.
+var editableProductPageVersionStates = []string{"PREPARE_FOR_SUBMISSION", "READY_FOR_REVIEW", "REJECTED"}
+
+type appCustomProductPageClient interface {
+	CreateCustomProductPage(ctx context.Context, page productpages.CustomProductPage, version productpages.Version, localizations []productpages.Localization) (*productpages.CustomProductPage, error)
+	GetCustomProductPage(ctx context.Context, id string) (*productpages.CustomProductPage, error)
+	ModifyCustomProductPage(ctx context.Context, id string, page productpages.CustomProductPage) (*productpages.CustomProductPage, error)
+	DeleteCustomProductPage(ctx context.Context, id string) error
+	ListCustomProductPageVersions(ctx context.Context, pageID string) ([]productpages.Version, error)
+	CreateCustomProductPageVersion(ctx context.Context, version productpages.Version) (*productpages.Version, error)
+	ModifyCustomProductPageVersion(ctx context.Context, id string, version productpages.Version) (*productpages.Version, error)
+	ListCustomProductPageLocalizations(ctx context.Context, versionID string) ([]productpages.Localization, error)
+	CreateCustomProductPageLocalization(ctx context.Context, localization productpages.Localization) (*productpages.Localization, error)
+	ModifyCustomProductPageLocalization(ctx context.Context, id string, localization productpages.Localization) (*productpages.Localization, error)
+	DeleteCustomProductPageLocalization(ctx context.Context, id string) error
+}
+
+func NewAppCustomProductPageResource() resource.Resource {
+	return &AppCustomProductPageResource{}
+}
+
+// AppCustomProductPageResource defines the resource implementation.
+type AppCustomProductPageResource struct {
+	client appCustomProductPageClient
+}
+
+// AppCustomProductPageResourceModel describes the resource data model.
+type AppCustomProductPageResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	AppID           types.String `tfsdk:"app_id"`
+	Name            types.String `tfsdk:"name"`
+	Visible         types.Bool   `tfsdk:"visible"`
+	DeepLink        types.String `tfsdk:"deep_link"`
+	URL             types.String `tfsdk:"url"`
+	VersionID       types.String `tfsdk:"version_id"`
+	VersionState    types.String `tfsdk:"version_state"`
+	LocalizationIDs types.Map    `tfsdk:"localization_ids"`
+	Localizations   types.Set    `tfsdk:"localization"`
+}
+
+// AppCustomProductPageLocalizationModel describes a `localization` block.
+type AppCustomProductPageLocalizationModel struct {
+	Locale          types.String `tfsdk:"locale"`
+	PromotionalText types.String `tfsdk:"promotional_text"`
+}
+
+var appCustomProductPageLocalizationAttrTypes = map[string]attr.Type{
+	"locale":           types.StringType,
+	"promotional_text": types.StringType,
+}
+
+func (r *AppCustomProductPageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_custom_product_page"
+}
+
+func (r *AppCustomProductPageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a custom product page, an alternative App Store page for an app that can be linked to " +
+			"from ad campaigns. Changes to the deep link or localizations are made on the page's editable version; once " +
+			"a version has been submitted for review, a new version is created. Attach screenshots with " +
+			"`appstoreconnect_screenshot_set` using an ID from `localization_ids`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the custom product page.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the app the page belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name used for the page in App Store Connect. It isn't shown to customers.",
+			},
+			"visible": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the page can be reached at its URL once approved.",
+			},
+			"deep_link": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A universal link into the app that customers who already have it are taken to.",
+			},
+			"url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The App Store URL of the page, for use in ad campaigns.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the page's current version.",
+			},
+			"version_state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The review state of the page's current version, e.g. `PREPARE_FOR_SUBMISSION` or `APPROVED`.",
+			},
+			"localization_ids": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The identifiers of the current version's localizations, keyed by locale.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"localization": schema.SetNestedBlock{
+				MarkdownDescription: "The promotional text of the page in a locale. At least one is required.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"locale": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The locale of the localization, e.g. `en-GB`.",
+						},
+						"promotional_text": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The promotional text shown at the top of the page's description.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *AppCustomProductPageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(appCustomProductPageClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected appCustomProductPageClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r AppCustomProductPageResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AppCustomProductPageResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Localizations.IsUnknown() {
+		return
+	}
+
+	var localizations []AppCustomProductPageLocalizationModel
+	resp.Diagnostics.Append(data.Localizations.ElementsAs(ctx, &localizations, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(localizations) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("localization"),
+			"Invalid Configuration",
+			"At least one `localization` block is required.",
+		)
+	}
+
+	seen := map[string]bool{}
+	for _, localization := range localizations {
+		if localization.Locale.IsUnknown() {
+			continue
+		}
+		locale := localization.Locale.ValueString()
+		if seen[locale] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("localization"),
+				"Invalid Configuration",
+				fmt.Sprintf("Locale %q is configured more than once.", locale),
+			)
+		}
+		seen[locale] = true
+	}
+}
+
+// ModifyPlan keeps the current version and localization IDs when they won't
+// change, so that screenshot sets attached to them aren't replaced.
+func (r AppCustomProductPageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to keep when the resource is being created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state AppCustomProductPageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.DeepLink.Equal(state.DeepLink) && plan.Localizations.Equal(state.Localizations) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("version_state"), state.VersionState)...)
+	} else if !slices.Contains(editableProductPageVersionStates, state.VersionState.ValueString()) {
+		// A new version will be created, with new localizations.
+		return
+	} else {
+		// An editable version is changed in place, so its localizations keep
+		// their identifiers as long as no locale is added or removed.
+		planned, diags := productPageLocales(ctx, plan.Localizations)
+		resp.Diagnostics.Append(diags...)
+		current, diags := productPageLocales(ctx, state.Localizations)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() || planned == nil || !slices.Equal(planned, current) {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("version_id"), state.VersionID)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("localization_ids"), state.LocalizationIDs)...)
+}
+
+// productPageLocales returns the sorted locales in localizations, or nil if
+// any are unknown.
+func productPageLocales(ctx context.Context, localizations types.Set) ([]string, diag.Diagnostics) {
+	if localizations.IsUnknown() {
+		return nil, nil
+	}
+
+	var models []AppCustomProductPageLocalizationModel
+	diags := localizations.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	locales := []string{}
+	for _, model := range models {
+		if model.Locale.IsUnknown() {
+			return nil, diags
+		}
+		locales = append(locales, model.Locale.ValueString())
+	}
+	slices.Sort(locales)
+	return locales, diags
+}
+
+func (r *AppCustomProductPageResource) populateState(ctx context.Context, data *AppCustomProductPageResourceModel, page *productpages.CustomProductPage, version *productpages.Version, localizations []productpages.Localization) diag.Diagnostics {
+	data.ID = types.StringValue(page.ID)
+	data.AppID = types.StringValue(page.AppID)
+	data.Name = types.StringValue(page.Name)
+	data.Visible = types.BoolValue(page.Visible)
+	data.URL = types.StringValue(page.URL)
+	data.VersionID = types.StringValue(version.ID)
+	data.VersionState = types.StringValue(version.State)
+	data.DeepLink = optionalString(version.DeepLink)
+
+	ids := map[string]string{}
+	models := []AppCustomProductPageLocalizationModel{}
+	for _, localization := range localizations {
+		ids[localization.Locale] = localization.ID
+		models = append(models, AppCustomProductPageLocalizationModel{
+			Locale:          types.StringValue(localization.Locale),
+			PromotionalText: optionalString(localization.PromotionalText),
+		})
+	}
+
+	var diags, d diag.Diagnostics
+	data.LocalizationIDs, d = types.MapValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	data.Localizations, d = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: appCustomProductPageLocalizationAttrTypes}, models)
+	diags.Append(d...)
+	return diags
+}
+
+func (r *AppCustomProductPageResource) localizations(ctx context.Context, data *AppCustomProductPageResourceModel) ([]productpages.Localization, diag.Diagnostics) {
+	var models []AppCustomProductPageLocalizationModel
+	diags := data.Localizations.ElementsAs(ctx, &models, false)
+
+	localizations := []productpages.Localization{}
+	for _, model := range models {
+		localizations = append(localizations, productpages.Localization{
+			Locale:          model.Locale.ValueString(),
+			PromotionalText: model.PromotionalText.ValueString(),
+		})
+	}
+	return localizations, diags
+}
+
+// currentVersion returns the page's newest version. Apple lists versions
+// oldest first.
+func (r *AppCustomProductPageResource) currentVersion(ctx context.Context, pageID string) (*productpages.Version, error) {
+	versions, err := r.client.ListCustomProductPageVersions(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("custom product page %q has no versions", pageID)
+	}
+	return &versions[len(versions)-1], nil
+}
+
+// editableVersion returns a version of the page that accepts changes, with
+// the deep link in data, creating a new version if the current one has been
+// submitted for review.
+func (r *AppCustomProductPageResource) editableVersion(ctx context.Context, data *AppCustomProductPageResourceModel, pageID string) (*productpages.Version, error) {
+	version, err := r.currentVersion(ctx, pageID)
+	if err != nil {
+		return nil, err
+	}
+
+	planned := productpages.Version{
+		PageID:   pageID,
+		DeepLink: data.DeepLink.ValueString(),
+	}
+
+	switch {
+	case !slices.Contains(editableProductPageVersionStates, version.State):
+		return r.client.CreateCustomProductPageVersion(ctx, planned)
+	case version.DeepLink != planned.DeepLink:
+		return r.client.ModifyCustomProductPageVersion(ctx, version.ID, planned)
+	}
+	return version, nil
+}
+
+// syncLocalizations creates, modifies and deletes the localizations of
+// version, matched by locale, and records the result, along with the page and
+// version, in data.
+func (r *AppCustomProductPageResource) syncLocalizations(ctx context.Context, data *AppCustomProductPageResourceModel, page *productpages.CustomProductPage, version *productpages.Version) diag.Diagnostics {
+	planned, diags := r.localizations(ctx, data)
+	if diags.HasError() {
+		return diags
+	}
+
+	existing, err := r.client.ListCustomProductPageLocalizations(ctx, version.ID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list custom product page localizations, got error: %s", err))
+		return diags
+	}
+
+	current := map[string]productpages.Localization{}
+	for _, localization := range existing {
+		current[localization.Locale] = localization
+	}
+
+	for _, localization := range planned {
+		localization.VersionID = version.ID
+
+		match, ok := current[localization.Locale]
+		delete(current, localization.Locale)
+
+		switch {
+		case !ok:
+			_, err = r.client.CreateCustomProductPageLocalization(ctx, localization)
+		case match.PromotionalText != localization.PromotionalText:
+			_, err = r.client.ModifyCustomProductPageLocalization(ctx, match.ID, localization)
+		}
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update %s localization, got error: %s", localization.Locale, err))
+			return diags
+		}
+	}
+
+	for locale, localization := range current {
+		if err := r.client.DeleteCustomProductPageLocalization(ctx, localization.ID); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete %s localization, got error: %s", locale, err))
+			return diags
+		}
+	}
+
+	existing, err = r.client.ListCustomProductPageLocalizations(ctx, version.ID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list custom product page localizations, got error: %s", err))
+		return diags
+	}
+
+	diags.Append(r.populateState(ctx, data, page, version, existing)...)
+	return diags
+}
+
+func (r *AppCustomProductPageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AppCustomProductPageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	localizations, diags := r.localizations(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apple creates the page together with its first version and localizations.
+	page, err := r.client.CreateCustomProductPage(ctx, productpages.CustomProductPage{
+		AppID:   data.AppID.ValueString(),
+		Name:    data.Name.ValueString(),
+		Visible: data.Visible.ValueBool(),
+	}, productpages.Version{
+		DeepLink: data.DeepLink.ValueString(),
+	}, localizations)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom product page, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a custom product page")
+
+	// Save the page before reading back its version and localizations.
+	created := data
+	created.ID = types.StringValue(page.ID)
+	created.URL = types.StringValue(page.URL)
+	created.VersionID = types.StringNull()
+	created.VersionState = types.StringNull()
+	created.LocalizationIDs = types.MapNull(types.StringType)
+	resp.Diagnostics.Append(saveCreated(ctx, &resp.State, &created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	version, err := r.currentVersion(ctx, page.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom product page version, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncLocalizations(ctx, &data, page, version)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppCustomProductPageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppCustomProductPageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	page, err := r.client.GetCustomProductPage(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom product page, got error: %s", err))
+		return
+	}
+
+	version, err := r.currentVersion(ctx, page.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom product page version, got error: %s", err))
+		return
+	}
+
+	localizations, err := r.client.ListCustomProductPageLocalizations(ctx, version.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list custom product page localizations, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, page, version, localizations)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppCustomProductPageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state AppCustomProductPageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	page, err := r.client.ModifyCustomProductPage(ctx, data.ID.ValueString(), productpages.CustomProductPage{
+		AppID:   data.AppID.ValueString(),
+		Name:    data.Name.ValueString(),
+		Visible: data.Visible.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify custom product page, got error: %s", err))
+		return
+	}
+
+	// Leave the current version alone unless its content changes, so that
+	// renaming an approved page doesn't create a new version.
+	var version *productpages.Version
+	if data.DeepLink.Equal(state.DeepLink) && data.Localizations.Equal(state.Localizations) {
+		version, err = r.currentVersion(ctx, page.ID)
+	} else {
+		version, err = r.editableVersion(ctx, &data, page.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom product page version, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified a custom product page")
+
+	resp.Diagnostics.Append(r.syncLocalizations(ctx, &data, page, version)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppCustomProductPageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AppCustomProductPageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCustomProductPage(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom product page, got error: %s", err))
+		return
+	}
+}
+
+func (r *AppCustomProductPageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,351 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/productpages"
+)
+
+// mockAppCustomProductPageClient stores a single page in memory.
+type mockAppCustomProductPageClient struct {
+	page          productpages.CustomProductPage
+	versions      []productpages.Version
+	localizations []productpages.Localization
+	nextID        int
+	listErr       error
+}
+
+func (m *mockAppCustomProductPageClient) id(prefix string) string {
+	m.nextID++
+	return fmt.Sprintf("%s-%d", prefix, m.nextID)
+}
+
+func (m *mockAppCustomProductPageClient) CreateCustomProductPage(ctx context.Context, page productpages.CustomProductPage, version productpages.Version, localizations []productpages.Localization) (*productpages.CustomProductPage, error) {
+	page.ID = "page-id"
+	page.URL = "https://apps.apple.com/app/id1234567890?ppid=page-id"
+	m.page = page
+
+	version.PageID = page.ID
+	version.State = "PREPARE_FOR_SUBMISSION"
+	created, _ := m.CreateCustomProductPageVersion(ctx, version)
+	for _, localization := range localizations {
+		localization.VersionID = created.ID
+		m.CreateCustomProductPageLocalization(ctx, localization)
+	}
+	return &m.page, nil
+}
+
+func (m *mockAppCustomProductPageClient) GetCustomProductPage(ctx context.Context, id string) (*productpages.CustomProductPage, error) {
+	return &m.page, nil
+}
+
+func (m *mockAppCustomProductPageClient) ModifyCustomProductPage(ctx context.Context, id string, page productpages.CustomProductPage) (*productpages.CustomProductPage, error) {
+	m.page.Name = page.Name
+	m.page.Visible = page.Visible
+	return &m.page, nil
+}
+
+func (m *mockAppCustomProductPageClient) DeleteCustomProductPage(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *mockAppCustomProductPageClient) ListCustomProductPageVersions(ctx context.Context, pageID string) ([]productpages.Version, error) {
+	if m.listErr != nil {
+		return nil, m.listErr
+	}
+	return m.versions, nil
+}
+
+func (m *mockAppCustomProductPageClient) CreateCustomProductPageVersion(ctx context.Context, version productpages.Version) (*productpages.Version, error) {
+	version.ID = m.id("version")
+	version.State = "PREPARE_FOR_SUBMISSION"
+	m.versions = append(m.versions, version)
+	return &version, nil
+}
+
+func (m *mockAppCustomProductPageClient) ModifyCustomProductPageVersion(ctx context.Context, id string, version productpages.Version) (*productpages.Version, error) {
+	for i := range m.versions {
+		if m.versions[i].ID == id {
+			m.versions[i].DeepLink = version.DeepLink
+			return &m.versions[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no version %q", id)
+}
+
+func (m *mockAppCustomProductPageClient) ListCustomProductPageLocalizations(ctx context.Context, versionID string) ([]productpages.Localization, error) {
+	localizations := []productpages.Localization{}
+	for _, localization := range m.localizations {
+		if localization.VersionID == versionID {
+			localizations = append(localizations, localization)
+		}
+	}
+	return localizations, nil
+}
+
+func (m *mockAppCustomProductPageClient) CreateCustomProductPageLocalization(ctx context.Context, localization productpages.Localization) (*productpages.Localization, error) {
+	localization.ID = m.id("localization")
+	m.localizations = append(m.localizations, localization)
+	return &localization, nil
+}
+
+func (m *mockAppCustomProductPageClient) ModifyCustomProductPageLocalization(ctx context.Context, id string, localization productpages.Localization) (*productpages.Localization, error) {
+	for i := range m.localizations {
+		if m.localizations[i].ID == id {
+			m.localizations[i].PromotionalText = localization.PromotionalText
+			return &m.localizations[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no localization %q", id)
+}
+
+func (m *mockAppCustomProductPageClient) DeleteCustomProductPageLocalization(ctx context.Context, id string) error {
+	for i := range m.localizations {
+		if m.localizations[i].ID == id {
+			m.localizations = append(m.localizations[:i], m.localizations[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("no localization %q", id)
+}
+
+func appCustomProductPageResourceSchema() schema.Schema {
+	r := &AppCustomProductPageResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+var appCustomProductPageLocalizationType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"locale":           tftypes.String,
+	"promotional_text": tftypes.String,
+}}
+
+// appCustomProductPageLocalizationsVal builds a `localization` set from
+// locale and promotional text pairs.
+func appCustomProductPageLocalizationsVal(pairs ...string) tftypes.Value {
+	elements := []tftypes.Value{}
+	for i := 0; i < len(pairs); i += 2 {
+		elements = append(elements, tftypes.NewValue(appCustomProductPageLocalizationType, map[string]tftypes.Value{
+			"locale":           tftypes.NewValue(tftypes.String, pairs[i]),
+			"promotional_text": tftypes.NewValue(tftypes.String, pairs[i+1]),
+		}))
+	}
+	return tftypes.NewValue(tftypes.Set{ElementType: appCustomProductPageLocalizationType}, elements)
+}
+
+func appCustomProductPageVal(s schema.Schema, name string, versionState interface{}, localizations tftypes.Value) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":               tftypes.NewValue(tftypes.String, "page-id"),
+		"app_id":           tftypes.NewValue(tftypes.String, "app-id"),
+		"name":             tftypes.NewValue(tftypes.String, name),
+		"visible":          tftypes.NewValue(tftypes.Bool, true),
+		"deep_link":        tftypes.NewValue(tftypes.String, "https://example.com/summer"),
+		"url":              tftypes.NewValue(tftypes.String, "https://apps.apple.com/app/id1234567890?ppid=page-id"),
+		"version_id":       tftypes.NewValue(tftypes.String, "version-1"),
+		"version_state":    tftypes.NewValue(tftypes.String, versionState),
+		"localization_ids": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{"en-GB": tftypes.NewValue(tftypes.String, "localization-2")}),
+		"localization":     localizations,
+	})
+}
+
+func TestAppCustomProductPageResource_Create_SetsStateCorrectly(t *testing.T) {
+	client := &mockAppCustomProductPageClient{}
+	r := &AppCustomProductPageResource{client: client}
+
+	s := appCustomProductPageResourceSchema()
+	planVal := appCustomProductPageVal(s, "Summer campaign", nil, appCustomProductPageLocalizationsVal("en-GB", "Summer sale", "fr-FR", "Soldes d'été"))
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.localizations) != 2 {
+		t.Errorf("expected 2 localizations to be created, got %v", client.localizations)
+	}
+
+	var data AppCustomProductPageResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.URL.ValueString() != "https://apps.apple.com/app/id1234567890?ppid=page-id" {
+		t.Errorf("expected page URL in state, got %q", data.URL.ValueString())
+	}
+	if data.DeepLink.ValueString() != "https://example.com/summer" {
+		t.Errorf("expected deep link in state, got %q", data.DeepLink.ValueString())
+	}
+
+	ids := map[string]string{}
+	data.LocalizationIDs.ElementsAs(context.Background(), &ids, false)
+	if ids["en-GB"] == "" || ids["fr-FR"] == "" {
+		t.Errorf("expected localization IDs for en-GB and fr-FR, got %v", ids)
+	}
+}
+
+func TestAppCustomProductPageResource_Create_SavesPageWhenVersionReadFails(t *testing.T) {
+	client := &mockAppCustomProductPageClient{listErr: fmt.Errorf("service unavailable")}
+	r := &AppCustomProductPageResource{client: client}
+
+	s := appCustomProductPageResourceSchema()
+	planVal := appCustomProductPageVal(s, "Summer campaign", nil, appCustomProductPageLocalizationsVal("en-GB", "Summer sale"))
+
+	state := createSavedState(t, r, s, planVal, "page-id")
+
+	var data AppCustomProductPageResourceModel
+	state.Get(context.Background(), &data)
+
+	if !data.LocalizationIDs.IsNull() {
+		t.Errorf("expected no localization IDs to be saved, got %v", data.LocalizationIDs)
+	}
+}
+
+func TestAppCustomProductPageResource_Update_CreatesVersionWhenApproved(t *testing.T) {
+	client := &mockAppCustomProductPageClient{
+		page:          productpages.CustomProductPage{ID: "page-id", AppID: "app-id", Name: "Summer campaign"},
+		versions:      []productpages.Version{{ID: "version-1", PageID: "page-id", State: "APPROVED", DeepLink: "https://example.com/summer"}},
+		localizations: []productpages.Localization{{ID: "localization-2", VersionID: "version-1", Locale: "en-GB", PromotionalText: "Summer sale"}},
+		nextID:        2,
+	}
+	r := &AppCustomProductPageResource{client: client}
+
+	s := appCustomProductPageResourceSchema()
+	stateVal := appCustomProductPageVal(s, "Summer campaign", "APPROVED", appCustomProductPageLocalizationsVal("en-GB", "Summer sale"))
+	planVal := appCustomProductPageVal(s, "Summer campaign", nil, appCustomProductPageLocalizationsVal("en-GB", "Last chance for the summer sale"))
+
+	req := resource.UpdateRequest{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Update(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.versions) != 2 {
+		t.Fatalf("expected a new version to be created, got %v", client.versions)
+	}
+	if client.localizations[0].PromotionalText != "Summer sale" {
+		t.Errorf("expected the approved version's localization to be unchanged, got %q", client.localizations[0].PromotionalText)
+	}
+
+	var data AppCustomProductPageResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.VersionID.ValueString() != "version-3" || data.VersionState.ValueString() != "PREPARE_FOR_SUBMISSION" {
+		t.Errorf("expected new version 'version-3' in PREPARE_FOR_SUBMISSION, got %q in %q", data.VersionID.ValueString(), data.VersionState.ValueString())
+	}
+}
+
+func TestAppCustomProductPageResource_ModifyPlan(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		versionState     string
+		localizations    tftypes.Value
+		expectKnownIDs   bool
+		expectKnownState bool
+	}{
+		{name: "rename only", versionState: "APPROVED", localizations: appCustomProductPageLocalizationsVal("en-GB", "Summer sale"), expectKnownIDs: true, expectKnownState: true},
+		{name: "editable text change", versionState: "PREPARE_FOR_SUBMISSION", localizations: appCustomProductPageLocalizationsVal("en-GB", "Summer sale ends soon"), expectKnownIDs: true},
+		{name: "editable locale added", versionState: "PREPARE_FOR_SUBMISSION", localizations: appCustomProductPageLocalizationsVal("en-GB", "Summer sale", "fr-FR", "Soldes d'été")},
+		{name: "approved text change", versionState: "APPROVED", localizations: appCustomProductPageLocalizationsVal("en-GB", "Summer sale ends soon")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := AppCustomProductPageResource{}
+
+			s := appCustomProductPageResourceSchema()
+			stateVal := appCustomProductPageVal(s, "Summer campaign", tc.versionState, appCustomProductPageLocalizationsVal("en-GB", "Summer sale"))
+
+			// Computed attributes without plan modifiers are unknown in a plan
+			// that changes the resource.
+			planVal := tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+				"id":               tftypes.NewValue(tftypes.String, "page-id"),
+				"app_id":           tftypes.NewValue(tftypes.String, "app-id"),
+				"name":             tftypes.NewValue(tftypes.String, "Summer campaign 2026"),
+				"visible":          tftypes.NewValue(tftypes.Bool, true),
+				"deep_link":        tftypes.NewValue(tftypes.String, "https://example.com/summer"),
+				"url":              tftypes.NewValue(tftypes.String, "https://apps.apple.com/app/id1234567890?ppid=page-id"),
+				"version_id":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"version_state":    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"localization_ids": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+				"localization":     tc.localizations,
+			})
+
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: s, Raw: stateVal},
+				Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+			}
+			resp := &resource.ModifyPlanResponse{
+				Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+			}
+
+			r.ModifyPlan(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+			}
+
+			var data AppCustomProductPageResourceModel
+			resp.Plan.Get(context.Background(), &data)
+
+			if data.LocalizationIDs.IsUnknown() == tc.expectKnownIDs || data.VersionID.IsUnknown() == tc.expectKnownIDs {
+				t.Errorf("expected known version and localization IDs=%t, got %v and %v", tc.expectKnownIDs, data.VersionID, data.LocalizationIDs)
+			}
+			if data.VersionState.IsUnknown() == tc.expectKnownState {
+				t.Errorf("expected known version state=%t, got %v", tc.expectKnownState, data.VersionState)
+			}
+			if tc.expectKnownIDs && !data.LocalizationIDs.Equal(types.MapValueMust(types.StringType, map[string]attr.Value{"en-GB": types.StringValue("localization-2")})) {
+				t.Errorf("expected localization IDs from state, got %v", data.LocalizationIDs)
+			}
+		})
+	}
+}
+
+func TestAppCustomProductPageResource_ValidateConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		localizations tftypes.Value
+		expectError   bool
+	}{
+		{name: "one locale", localizations: appCustomProductPageLocalizationsVal("en-GB", "Summer sale")},
+		{name: "no locales", localizations: appCustomProductPageLocalizationsVal(), expectError: true},
+		{name: "duplicate locale", localizations: appCustomProductPageLocalizationsVal("en-GB", "Summer sale", "en-GB", "Summer savings"), expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := AppCustomProductPageResource{}
+
+			s := appCustomProductPageResourceSchema()
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: appCustomProductPageVal(s, "Summer campaign", nil, tc.localizations)},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error=%t, got diagnostics %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
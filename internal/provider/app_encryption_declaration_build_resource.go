@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AppEncryptionDeclarationBuildResource{}
+var _ resource.ResourceWithImportState = &AppEncryptionDeclarationBuildResource{}
+
+type appEncryptionDeclarationBuildClient interface {
+	GetBuildAppEncryptionDeclarationID(ctx context.Context, buildID string) (string, error)
+	SetBuildAppEncryptionDeclaration(ctx context.Context, buildID string, declarationID string) error
+}
+
+func NewAppEncryptionDeclarationBuildResource() resource.Resource {
+	return &AppEncryptionDeclarationBuildResource{}
+}
+
+// AppEncryptionDeclarationBuildResource defines the resource implementation.
+type AppEncryptionDeclarationBuildResource struct {
+	client appEncryptionDeclarationBuildClient
+}
+
+// AppEncryptionDeclarationBuildResourceModel describes the resource data model.
+type AppEncryptionDeclarationBuildResourceModel struct {
+	ID                         types.String `tfsdk:"id"`
+	AppEncryptionDeclarationID types.String `tfsdk:"app_encryption_declaration_id"`
+	BuildID                    types.String `tfsdk:"build_id"`
+}
+
+func (r *AppEncryptionDeclarationBuildResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_encryption_declaration_build"
+}
+
+func (r *AppEncryptionDeclarationBuildResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Attaches an export compliance declaration to a build, so that the build can be tested and submitted " +
+			"without answering export compliance questions. A build's declaration can't be removed, so destroying this " +
+			"resource only removes it from state. Use the `appstoreconnect_build` data source to select a build.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the build.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_encryption_declaration_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the declaration to attach.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"build_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the build to attach the declaration to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AppEncryptionDeclarationBuildResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(appEncryptionDeclarationBuildClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected appEncryptionDeclarationBuildClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AppEncryptionDeclarationBuildResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AppEncryptionDeclarationBuildResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetBuildAppEncryptionDeclaration(ctx, data.BuildID.ValueString(), data.AppEncryptionDeclarationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to attach app encryption declaration to build, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "attached an app encryption declaration to a build")
+
+	data.ID = data.BuildID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppEncryptionDeclarationBuildResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppEncryptionDeclarationBuildResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	declarationID, err := r.client.GetBuildAppEncryptionDeclarationID(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read build app encryption declaration, got error: %s", err))
+		return
+	}
+	if declarationID == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.BuildID = data.ID
+	data.AppEncryptionDeclarationID = types.StringValue(declarationID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppEncryptionDeclarationBuildResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute requires replacement, so there is nothing to update.
+	var data AppEncryptionDeclarationBuildResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppEncryptionDeclarationBuildResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A build's declaration cannot be removed, so it is only removed from state.
+	tflog.Trace(ctx, "removed build app encryption declaration from state")
+}
+
+func (r *AppEncryptionDeclarationBuildResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type mockAppEncryptionDeclarationBuildClient struct {
+	declarations map[string]string
+}
+
+func (m *mockAppEncryptionDeclarationBuildClient) GetBuildAppEncryptionDeclarationID(ctx context.Context, buildID string) (string, error) {
+	return m.declarations[buildID], nil
+}
+
+func (m *mockAppEncryptionDeclarationBuildClient) SetBuildAppEncryptionDeclaration(ctx context.Context, buildID string, declarationID string) error {
+	m.declarations[buildID] = declarationID
+	return nil
+}
+
+func appEncryptionDeclarationBuildResourceSchema() schema.Schema {
+	r := &AppEncryptionDeclarationBuildResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func appEncryptionDeclarationBuildVal(s schema.Schema, id interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                            tftypes.NewValue(tftypes.String, id),
+		"app_encryption_declaration_id": tftypes.NewValue(tftypes.String, "declaration-id"),
+		"build_id":                      tftypes.NewValue(tftypes.String, "build-id"),
+	})
+}
+
+func TestAppEncryptionDeclarationBuildResource_Create_AttachesDeclaration(t *testing.T) {
+	client := &mockAppEncryptionDeclarationBuildClient{declarations: map[string]string{}}
+	r := &AppEncryptionDeclarationBuildResource{client: client}
+
+	s := appEncryptionDeclarationBuildResourceSchema()
+	planVal := appEncryptionDeclarationBuildVal(s, tftypes.UnknownValue)
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if client.declarations["build-id"] != "declaration-id" {
+		t.Errorf("expected the declaration to be attached to the build, got %v", client.declarations)
+	}
+
+	var data AppEncryptionDeclarationBuildResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "build-id" {
+		t.Errorf("expected ID 'build-id', got %q", data.ID.ValueString())
+	}
+}
+
+func TestAppEncryptionDeclarationBuildResource_Read_RemovesWhenNoDeclaration(t *testing.T) {
+	r := &AppEncryptionDeclarationBuildResource{client: &mockAppEncryptionDeclarationBuildClient{declarations: map[string]string{}}}
+
+	s := appEncryptionDeclarationBuildResourceSchema()
+	stateVal := appEncryptionDeclarationBuildVal(s, "build-id")
+
+	req := resource.ReadRequest{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+	resp := &resource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("expected the resource to be removed from state")
+	}
+}
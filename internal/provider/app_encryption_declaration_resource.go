@@ -0,0 +1,373 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/encryption"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AppEncryptionDeclarationResource{}
+var _ resource.ResourceWithImportState = &AppEncryptionDeclarationResource{}
+var _ resource.ResourceWithValidateConfig = &AppEncryptionDeclarationResource{}
+var _ resource.ResourceWithModifyPlan = &AppEncryptionDeclarationResource{}
+
+type appEncryptionDeclarationClient interface {
+	CreateAppEncryptionDeclaration(ctx context.Context, declaration encryption.Declaration) (*encryption.Declaration, error)
+	GetAppEncryptionDeclaration(ctx context.Context, id string) (*encryption.Declaration, error)
+	ReserveAppEncryptionDeclarationDocument(ctx context.Context, declarationID string, fileName string, fileSize int64) (*encryption.Document, error)
+	CommitAppEncryptionDeclarationDocument(ctx context.Context, id string, checksum string) (*encryption.Document, error)
+	GetAppEncryptionDeclarationDocument(ctx context.Context, declarationID string) (*encryption.Document, error)
+}
+
+func NewAppEncryptionDeclarationResource() resource.Resource {
+	return &AppEncryptionDeclarationResource{}
+}
+
+// AppEncryptionDeclarationResource defines the resource implementation.
+type AppEncryptionDeclarationResource struct {
+	client appEncryptionDeclarationClient
+}
+
+// AppEncryptionDeclarationResourceModel describes the resource data model.
+type AppEncryptionDeclarationResourceModel struct {
+	ID                              types.String `tfsdk:"id"`
+	AppID                           types.String `tfsdk:"app_id"`
+	AppDescription                  types.String `tfsdk:"app_description"`
+	UsesEncryption                  types.Bool   `tfsdk:"uses_encryption"`
+	Exempt                          types.Bool   `tfsdk:"exempt"`
+	ContainsProprietaryCryptography types.Bool   `tfsdk:"contains_proprietary_cryptography"`
+	ContainsThirdPartyCryptography  types.Bool   `tfsdk:"contains_third_party_cryptography"`
+	AvailableOnFrenchStore          types.Bool   `tfsdk:"available_on_french_store"`
+	DocumentFilePath                types.String `tfsdk:"document_file_path"`
+	DocumentChecksum                types.String `tfsdk:"document_checksum"`
+	CodeValue                       types.String `tfsdk:"code_value"`
+	State                           types.String `tfsdk:"state"`
+}
+
+func (r *AppEncryptionDeclarationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_encryption_declaration"
+}
+
+// encryptionAnswerAttribute describes an export compliance answer. Declarations
+// can't be changed once submitted, so every answer forces a new declaration.
+func encryptionAnswerAttribute(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: description,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func (r *AppEncryptionDeclarationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an export compliance declaration describing an app's use of encryption. " +
+			"Declarations can't be changed or deleted once created, so any change creates a new declaration and " +
+			"destroying this resource only removes it from state. Attach the declaration to builds with " +
+			"`appstoreconnect_app_encryption_declaration_build`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the declaration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the app the declaration belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"app_description": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "A description of how the app uses encryption, for the export compliance review.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"uses_encryption":                   encryptionAnswerAttribute("Whether the app uses, accesses, contains or implements encryption. Defaults to `false`."),
+			"exempt":                            encryptionAnswerAttribute("Whether the app only uses encryption that is exempt from export documentation requirements. Defaults to `false`."),
+			"contains_proprietary_cryptography": encryptionAnswerAttribute("Whether the app implements proprietary encryption algorithms. Defaults to `false`."),
+			"contains_third_party_cryptography": encryptionAnswerAttribute("Whether the app implements standard encryption algorithms instead of, or in addition to, those provided by Apple's operating systems. Defaults to `false`."),
+			"available_on_french_store":         encryptionAnswerAttribute("Whether the app is distributed on the App Store in France. Defaults to `false`."),
+			"document_file_path": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The path to export compliance documentation to upload, such as an encryption registration or a French declaration. " +
+					"Required when the app uses encryption that isn't exempt. The declaration is replaced whenever the file's MD5 checksum changes.",
+			},
+			"document_checksum": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The MD5 checksum of the uploaded documentation, as stored by Apple.",
+			},
+			"code_value": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The export compliance code to set as `ITSEncryptionExportComplianceCode` in the app's `Info.plist`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The review state of the declaration (e.g. `IN_REVIEW`, `APPROVED`, `REJECTED`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AppEncryptionDeclarationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(appEncryptionDeclarationClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected appEncryptionDeclarationClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r AppEncryptionDeclarationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AppEncryptionDeclarationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.UsesEncryption.IsUnknown() {
+		return
+	}
+
+	if !data.UsesEncryption.ValueBool() {
+		for _, answer := range []struct {
+			attribute string
+			value     types.Bool
+		}{
+			{"exempt", data.Exempt},
+			{"contains_proprietary_cryptography", data.ContainsProprietaryCryptography},
+			{"contains_third_party_cryptography", data.ContainsThirdPartyCryptography},
+		} {
+			if answer.value.ValueBool() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(answer.attribute),
+					"Invalid Configuration",
+					fmt.Sprintf("`%s` can only be true when `uses_encryption` is true.", answer.attribute),
+				)
+			}
+		}
+		return
+	}
+
+	if !data.Exempt.IsUnknown() && !data.Exempt.ValueBool() && data.DocumentFilePath.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("document_file_path"),
+			"Invalid Configuration",
+			"`document_file_path` is required when the app uses encryption that isn't exempt.",
+		)
+	}
+}
+
+func (r AppEncryptionDeclarationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan AppEncryptionDeclarationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.DocumentFilePath.IsUnknown() {
+		return
+	}
+
+	checksum := types.StringNull()
+	if !plan.DocumentFilePath.IsNull() {
+		_, sum, err := readAsset(plan.DocumentFilePath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("document_file_path"),
+				"Unable to read document",
+				fmt.Sprintf("Unable to read %q, got error: %s", plan.DocumentFilePath.ValueString(), err),
+			)
+			return
+		}
+		checksum = types.StringValue(sum)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("document_checksum"), checksum)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state AppEncryptionDeclarationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	// Declarations cannot be modified, so different documentation means a new declaration.
+	if !state.DocumentChecksum.Equal(checksum) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("document_checksum"))
+	}
+}
+
+func (r *AppEncryptionDeclarationResource) populateState(data *AppEncryptionDeclarationResourceModel, declaration *encryption.Declaration) {
+	data.ID = types.StringValue(declaration.ID)
+	data.AppID = types.StringValue(declaration.AppID)
+	data.AppDescription = types.StringValue(declaration.AppDescription)
+	data.UsesEncryption = types.BoolValue(declaration.UsesEncryption)
+	data.Exempt = types.BoolValue(declaration.Exempt)
+	data.ContainsProprietaryCryptography = types.BoolValue(declaration.ContainsProprietaryCryptography)
+	data.ContainsThirdPartyCryptography = types.BoolValue(declaration.ContainsThirdPartyCryptography)
+	data.AvailableOnFrenchStore = types.BoolValue(declaration.AvailableOnFrenchStore)
+	data.CodeValue = types.StringValue(declaration.CodeValue)
+	data.State = types.StringValue(declaration.State)
+}
+
+// uploadDocument uploads the documentation at filePath to the declaration and
+// returns the checksum Apple recorded.
+func (r *AppEncryptionDeclarationResource) uploadDocument(ctx context.Context, declarationID string, filePath string) (string, error) {
+	file, checksum, err := readAsset(filePath)
+	if err != nil {
+		return "", fmt.Errorf("unable to read document: %w", err)
+	}
+
+	reservation, err := r.client.ReserveAppEncryptionDeclarationDocument(ctx, declarationID, filepath.Base(filePath), int64(len(file)))
+	if err != nil {
+		return "", fmt.Errorf("unable to reserve document: %w", err)
+	}
+
+	err = uploadAsset(ctx, reservation.UploadOperations, file)
+	if err != nil {
+		return "", fmt.Errorf("unable to upload document: %w", err)
+	}
+
+	document, err := r.client.CommitAppEncryptionDeclarationDocument(ctx, reservation.ID, checksum)
+	if err != nil {
+		return "", fmt.Errorf("unable to commit document: %w", err)
+	}
+
+	return document.SourceFileChecksum, nil
+}
+
+func (r *AppEncryptionDeclarationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AppEncryptionDeclarationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	declaration, err := r.client.CreateAppEncryptionDeclaration(ctx, encryption.Declaration{
+		AppID:                           data.AppID.ValueString(),
+		AppDescription:                  data.AppDescription.ValueString(),
+		UsesEncryption:                  data.UsesEncryption.ValueBool(),
+		Exempt:                          data.Exempt.ValueBool(),
+		ContainsProprietaryCryptography: data.ContainsProprietaryCryptography.ValueBool(),
+		ContainsThirdPartyCryptography:  data.ContainsThirdPartyCryptography.ValueBool(),
+		AvailableOnFrenchStore:          data.AvailableOnFrenchStore.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create app encryption declaration, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an app encryption declaration")
+
+	// Save the declaration before uploading its documentation.
+	r.populateState(&data, declaration)
+	created := data
+	created.DocumentChecksum = types.StringNull()
+	resp.Diagnostics.Append(saveCreated(ctx, &resp.State, &created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.DocumentFilePath.IsNull() {
+		checksum, err := r.uploadDocument(ctx, declaration.ID, data.DocumentFilePath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Upload Error", fmt.Sprintf("Unable to upload app encryption declaration document, got error: %s", err))
+			return
+		}
+		data.DocumentChecksum = types.StringValue(checksum)
+
+		tflog.Trace(ctx, "uploaded an app encryption declaration document")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppEncryptionDeclarationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppEncryptionDeclarationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	declaration, err := r.client.GetAppEncryptionDeclaration(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app encryption declaration, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, declaration)
+
+	if !data.DocumentFilePath.IsNull() {
+		document, err := r.client.GetAppEncryptionDeclarationDocument(ctx, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app encryption declaration document, got error: %s", err))
+			return
+		}
+		data.DocumentChecksum = optionalString(document.SourceFileChecksum)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppEncryptionDeclarationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every answer and the documentation require replacement, so only the
+	// path to an unchanged document can be updated.
+	var data AppEncryptionDeclarationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppEncryptionDeclarationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// App encryption declarations cannot be deleted, so they are only removed from state.
+	tflog.Trace(ctx, "removed app encryption declaration from state")
+}
+
+func (r *AppEncryptionDeclarationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
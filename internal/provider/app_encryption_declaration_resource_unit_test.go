@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/encryption"
+)
+
+type mockAppEncryptionDeclarationClient struct {
+	created           *encryption.Declaration
+	reservedFor       string
+	reservedName      string
+	committedChecksum string
+	reserveErr        error
+}
+
+func (m *mockAppEncryptionDeclarationClient) CreateAppEncryptionDeclaration(ctx context.Context, declaration encryption.Declaration) (*encryption.Declaration, error) {
+	sent := declaration
+	m.created = &sent
+
+	declaration.ID = "declaration-id"
+	declaration.CodeValue = "abc123"
+	declaration.State = "IN_REVIEW"
+	return &declaration, nil
+}
+
+func (m *mockAppEncryptionDeclarationClient) GetAppEncryptionDeclaration(ctx context.Context, id string) (*encryption.Declaration, error) {
+	return &encryption.Declaration{ID: id}, nil
+}
+
+func (m *mockAppEncryptionDeclarationClient) ReserveAppEncryptionDeclarationDocument(ctx context.Context, declarationID string, fileName string, fileSize int64) (*encryption.Document, error) {
+	m.reservedFor = declarationID
+	m.reservedName = fileName
+	if m.reserveErr != nil {
+		return nil, m.reserveErr
+	}
+	return &encryption.Document{ID: "document-id"}, nil
+}
+
+func (m *mockAppEncryptionDeclarationClient) CommitAppEncryptionDeclarationDocument(ctx context.Context, id string, checksum string) (*encryption.Document, error) {
+	m.committedChecksum = checksum
+	return &encryption.Document{ID: id, SourceFileChecksum: checksum}, nil
+}
+
+func (m *mockAppEncryptionDeclarationClient) GetAppEncryptionDeclarationDocument(ctx context.Context, declarationID string) (*encryption.Document, error) {
+	return &encryption.Document{ID: "document-id"}, nil
+}
+
+func appEncryptionDeclarationResourceSchema() schema.Schema {
+	r := &AppEncryptionDeclarationResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func writeEncryptionDocument(t *testing.T, contents string) string {
+	t.Helper()
+	filePath := filepath.Join(t.TempDir(), "ccats.pdf")
+	if err := os.WriteFile(filePath, []byte(contents), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return filePath
+}
+
+func appEncryptionDeclarationVal(s schema.Schema, usesEncryption bool, exempt bool, documentFilePath interface{}, checksum interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                                tftypes.NewValue(tftypes.String, nil),
+		"app_id":                            tftypes.NewValue(tftypes.String, "app-id"),
+		"app_description":                   tftypes.NewValue(tftypes.String, "Uses TLS and end-to-end encrypted messaging."),
+		"uses_encryption":                   tftypes.NewValue(tftypes.Bool, usesEncryption),
+		"exempt":                            tftypes.NewValue(tftypes.Bool, exempt),
+		"contains_proprietary_cryptography": tftypes.NewValue(tftypes.Bool, false),
+		"contains_third_party_cryptography": tftypes.NewValue(tftypes.Bool, usesEncryption),
+		"available_on_french_store":         tftypes.NewValue(tftypes.Bool, true),
+		"document_file_path":                tftypes.NewValue(tftypes.String, documentFilePath),
+		"document_checksum":                 tftypes.NewValue(tftypes.String, checksum),
+		"code_value":                        tftypes.NewValue(tftypes.String, nil),
+		"state":                             tftypes.NewValue(tftypes.String, nil),
+	})
+}
+
+func TestAppEncryptionDeclarationResource_Create_UploadsDocument(t *testing.T) {
+	filePath := writeEncryptionDocument(t, "hello world")
+
+	client := &mockAppEncryptionDeclarationClient{}
+	r := &AppEncryptionDeclarationResource{client: client}
+
+	s := appEncryptionDeclarationResourceSchema()
+	planVal := appEncryptionDeclarationVal(s, true, false, filePath, "5eb63bbbe01eeed093cb22bb8f5acdc3")
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if !client.created.UsesEncryption || !client.created.ContainsThirdPartyCryptography || !client.created.AvailableOnFrenchStore {
+		t.Errorf("expected answers to be sent, got %+v", client.created)
+	}
+	if client.reservedFor != "declaration-id" || client.reservedName != "ccats.pdf" {
+		t.Errorf("expected 'ccats.pdf' to be reserved for the new declaration, got %q for %q", client.reservedName, client.reservedFor)
+	}
+	if client.committedChecksum != "5eb63bbbe01eeed093cb22bb8f5acdc3" {
+		t.Errorf("expected commit with the file's MD5 checksum, got %q", client.committedChecksum)
+	}
+
+	var data AppEncryptionDeclarationResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.CodeValue.ValueString() != "abc123" {
+		t.Errorf("expected code value 'abc123', got %q", data.CodeValue.ValueString())
+	}
+	if data.DocumentChecksum.ValueString() != "5eb63bbbe01eeed093cb22bb8f5acdc3" {
+		t.Errorf("expected document checksum in state, got %q", data.DocumentChecksum.ValueString())
+	}
+}
+
+func TestAppEncryptionDeclarationResource_Create_SavesDeclarationWhenUploadFails(t *testing.T) {
+	filePath := writeEncryptionDocument(t, "hello world")
+
+	client := &mockAppEncryptionDeclarationClient{reserveErr: fmt.Errorf("service unavailable")}
+	r := &AppEncryptionDeclarationResource{client: client}
+
+	s := appEncryptionDeclarationResourceSchema()
+	planVal := appEncryptionDeclarationVal(s, true, false, filePath, "5eb63bbbe01eeed093cb22bb8f5acdc3")
+
+	state := createSavedState(t, r, s, planVal, "declaration-id")
+
+	var data AppEncryptionDeclarationResourceModel
+	state.Get(context.Background(), &data)
+
+	if !data.DocumentChecksum.IsNull() {
+		t.Errorf("expected no document checksum in state, got %q", data.DocumentChecksum.ValueString())
+	}
+}
+
+func TestAppEncryptionDeclarationResource_ModifyPlan_RequiresReplaceWhenDocumentChanges(t *testing.T) {
+	filePath := writeEncryptionDocument(t, "hello world")
+
+	r := AppEncryptionDeclarationResource{client: &mockAppEncryptionDeclarationClient{}}
+
+	s := appEncryptionDeclarationResourceSchema()
+	stateVal := appEncryptionDeclarationVal(s, true, false, filePath, "checksum-from-apple")
+	planVal := appEncryptionDeclarationVal(s, true, false, filePath, tftypes.UnknownValue)
+
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.ModifyPlanResponse{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+
+	r.ModifyPlan(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(resp.RequiresReplace) != 1 || !resp.RequiresReplace[0].Equal(path.Root("document_checksum")) {
+		t.Errorf("expected replacement on document_checksum, got %v", resp.RequiresReplace)
+	}
+}
+
+func TestAppEncryptionDeclarationResource_ValidateConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		usesEncryption   bool
+		exempt           bool
+		documentFilePath interface{}
+		expectError      bool
+	}{
+		{name: "no encryption"},
+		{name: "exempt encryption", usesEncryption: true, exempt: true},
+		{name: "documented encryption", usesEncryption: true, documentFilePath: "ccats.pdf"},
+		{name: "undocumented encryption", usesEncryption: true, expectError: true},
+		{name: "exempt without encryption", exempt: true, expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := AppEncryptionDeclarationResource{}
+
+			s := appEncryptionDeclarationResourceSchema()
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: appEncryptionDeclarationVal(s, tc.usesEncryption, tc.exempt, tc.documentFilePath, nil)},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error=%t, got diagnostics %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
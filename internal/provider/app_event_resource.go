@@ -0,0 +1,747 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/events"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AppEventResource{}
+var _ resource.ResourceWithImportState = &AppEventResource{}
+var _ resource.ResourceWithValidateConfig = &AppEventResource{}
+var _ resource.ResourceWithModifyPlan = &AppEventResource{}
+
+// appEventBadges are the badges Apple shows on an event card.
+var appEventBadges = []string{"LIVE_EVENT", "PREMIERE", "CHALLENGE", "COMPETITION", "NEW_SEASON", "MAJOR_UPDATE", "SPECIAL_EVENT"}
+
+// appEventPurchaseRequirements describe what customers need to buy to take part in an event.
+var appEventPurchaseRequirements = []string{
+	"NO_COST_ASSOCIATED",
+	"IN_APP_PURCHASE",
+	"SUBSCRIPTION",
+	"IN_APP_PURCHASE_AND_SUBSCRIPTION",
+	"IN_APP_PURCHASE_OR_SUBSCRIPTION",
+}
+
+// appEventPriorities are the priorities Apple uses to order an app's events.
+var appEventPriorities = []string{"HIGH", "NORMAL"}
+
+// appEventPurposes are the audiences an event can be aimed at.
+var appEventPurposes = []string{"APPROPRIATE_FOR_ALL_USERS", "ATTRACT_NEW_USERS", "KEEP_ACTIVE_USERS_INFORMED", "BRING_BACK_LAPSED_USERS"}
+
+type appEventClient interface {
+	CreateAppEvent(ctx context.Context, event events.Event) (*events.Event, error)
+	GetAppEvent(ctx context.Context, id string) (*events.Event, error)
+	ModifyAppEvent(ctx context.Context, id string, event events.Event) (*events.Event, error)
+	DeleteAppEvent(ctx context.Context, id string) error
+	ListAppEventLocalizations(ctx context.Context, eventID string) ([]events.Localization, error)
+	CreateAppEventLocalization(ctx context.Context, localization events.Localization) (*events.Localization, error)
+	ModifyAppEventLocalization(ctx context.Context, id string, localization events.Localization) (*events.Localization, error)
+	DeleteAppEventLocalization(ctx context.Context, id string) error
+}
+
+func NewAppEventResource() resource.Resource {
+	return &AppEventResource{}
+}
+
+// AppEventResource defines the resource implementation.
+type AppEventResource struct {
+	client appEventClient
+}
+
+// AppEventResourceModel describes the resource data model.
+type AppEventResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	AppID               types.String `tfsdk:"app_id"`
+	ReferenceName       types.String `tfsdk:"reference_name"`
+	Badge               types.String `tfsdk:"badge"`
+	DeepLink            types.String `tfsdk:"deep_link"`
+	PurchaseRequirement types.String `tfsdk:"purchase_requirement"`
+	PrimaryLocale       types.String `tfsdk:"primary_locale"`
+	Priority            types.String `tfsdk:"priority"`
+	Purpose             types.String `tfsdk:"purpose"`
+	State               types.String `tfsdk:"state"`
+	LocalizationIDs     types.Map    `tfsdk:"localization_ids"`
+	TerritorySchedules  types.Set    `tfsdk:"territory_schedule"`
+	Localizations       types.Set    `tfsdk:"localization"`
+}
+
+// AppEventTerritoryScheduleModel describes a `territory_schedule` block.
+type AppEventTerritoryScheduleModel struct {
+	Territories  types.Set    `tfsdk:"territories"`
+	PublishStart types.String `tfsdk:"publish_start"`
+	EventStart   types.String `tfsdk:"event_start"`
+	EventEnd     types.String `tfsdk:"event_end"`
+}
+
+var appEventTerritoryScheduleAttrTypes = map[string]attr.Type{
+	"territories":   types.SetType{ElemType: types.StringType},
+	"publish_start": types.StringType,
+	"event_start":   types.StringType,
+	"event_end":     types.StringType,
+}
+
+// AppEventLocalizationModel describes a `localization` block.
+type AppEventLocalizationModel struct {
+	Locale           types.String `tfsdk:"locale"`
+	Name             types.String `tfsdk:"name"`
+	ShortDescription types.String `tfsdk:"short_description"`
+	LongDescription  types.String `tfsdk:"long_description"`
+}
+
+var appEventLocalizationAttrTypes = map[string]attr.Type{
+	"locale":            types.StringType,
+	"name":              types.StringType,
+	"short_description": types.StringType,
+	"long_description":  types.StringType,
+}
+
+func (r *AppEventResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_event"
+}
+
+func (r *AppEventResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an in-app event, a timely event within an app that is promoted on the App Store. " +
+			"Upload the event card and details page media with `appstoreconnect_app_event_screenshot` and " +
+			"`appstoreconnect_app_event_video_clip`, using an ID from `localization_ids`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the event.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the app the event belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reference_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name used for the event in App Store Connect.",
+			},
+			"badge": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The badge shown on the event card: `LIVE_EVENT`, `PREMIERE`, `CHALLENGE`, `COMPETITION`, `NEW_SEASON`, `MAJOR_UPDATE` or `SPECIAL_EVENT`.",
+			},
+			"deep_link": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A universal link that opens the event in the app.",
+			},
+			"purchase_requirement": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("NO_COST_ASSOCIATED"),
+				MarkdownDescription: "What customers need to buy to take part: `NO_COST_ASSOCIATED`, `IN_APP_PURCHASE`, `SUBSCRIPTION`, `IN_APP_PURCHASE_AND_SUBSCRIPTION` or `IN_APP_PURCHASE_OR_SUBSCRIPTION`. Defaults to `NO_COST_ASSOCIATED`.",
+			},
+			"primary_locale": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The locale shown to customers whose locale the event isn't localized in. A `localization` is required for it.",
+			},
+			"priority": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("NORMAL"),
+				MarkdownDescription: "The priority of the event relative to the app's other events: `HIGH` or `NORMAL`. Defaults to `NORMAL`.",
+			},
+			"purpose": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The audience the event is aimed at: `APPROPRIATE_FOR_ALL_USERS`, `ATTRACT_NEW_USERS`, `KEEP_ACTIVE_USERS_INFORMED` or `BRING_BACK_LAPSED_USERS`.",
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The state of the event, e.g. `DRAFT`, `ACCEPTED` or `PUBLISHED`.",
+			},
+			"localization_ids": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The identifiers of the event's localizations, keyed by locale.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"territory_schedule": schema.SetNestedBlock{
+				MarkdownDescription: "When the event is shown and runs in a group of territories. At least one is required. Times are in RFC 3339 format, e.g. `2026-07-01T09:00:00Z`.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"territories": schema.SetAttribute{
+							Required:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The three-letter codes of the territories the schedule applies to, e.g. `GBR`.",
+						},
+						"publish_start": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "When the event starts being promoted on the App Store.",
+						},
+						"event_start": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "When the event starts.",
+						},
+						"event_end": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "When the event ends.",
+						},
+					},
+				},
+			},
+			"localization": schema.SetNestedBlock{
+				MarkdownDescription: "The name and descriptions of the event shown on the App Store in a locale.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"locale": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The locale of the localization, e.g. `en-GB`.",
+						},
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the event.",
+						},
+						"short_description": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The short description shown on the event card.",
+						},
+						"long_description": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The long description shown on the event details page.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *AppEventResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(appEventClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected appEventClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r AppEventResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AppEventResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, enum := range []struct {
+		attribute string
+		value     types.String
+		allowed   []string
+	}{
+		{"badge", data.Badge, appEventBadges},
+		{"purchase_requirement", data.PurchaseRequirement, appEventPurchaseRequirements},
+		{"priority", data.Priority, appEventPriorities},
+		{"purpose", data.Purpose, appEventPurposes},
+	} {
+		if !enum.value.IsNull() && !enum.value.IsUnknown() && !slices.Contains(enum.allowed, enum.value.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(enum.attribute),
+				"Invalid Configuration",
+				fmt.Sprintf("`%s` must be one of %s, got %q.", enum.attribute, strings.Join(enum.allowed, ", "), enum.value.ValueString()),
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(validateAppEventSchedules(ctx, data.TerritorySchedules)...)
+	resp.Diagnostics.Append(validateAppEventLocalizations(ctx, data.PrimaryLocale, data.Localizations)...)
+}
+
+// validateAppEventSchedules checks that there is at least one schedule, that
+// its territories and times are well-formed, and that each event starts
+// after it is published and ends after it starts.
+func validateAppEventSchedules(ctx context.Context, schedules types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if schedules.IsUnknown() {
+		return diags
+	}
+
+	var models []AppEventTerritoryScheduleModel
+	diags.Append(schedules.ElementsAs(ctx, &models, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(models) == 0 {
+		diags.AddAttributeError(
+			path.Root("territory_schedule"),
+			"Invalid Configuration",
+			"At least one `territory_schedule` block is required.",
+		)
+	}
+
+	for _, model := range models {
+		if !model.Territories.IsUnknown() {
+			var territories []types.String
+			diags.Append(model.Territories.ElementsAs(ctx, &territories, false)...)
+
+			if len(territories) == 0 {
+				diags.AddAttributeError(
+					path.Root("territory_schedule"),
+					"Invalid Configuration",
+					"Each `territory_schedule` needs at least one territory.",
+				)
+			}
+			for _, territory := range territories {
+				if !territory.IsUnknown() && !isTerritoryCode(territory.ValueString()) {
+					diags.AddAttributeError(
+						path.Root("territory_schedule"),
+						"Invalid Configuration",
+						fmt.Sprintf("`territories` must only contain three-letter territory codes such as `GBR`, got %q.", territory.ValueString()),
+					)
+				}
+			}
+		}
+
+		times := map[string]time.Time{}
+		for _, value := range []struct {
+			attribute string
+			value     types.String
+		}{
+			{"publish_start", model.PublishStart},
+			{"event_start", model.EventStart},
+			{"event_end", model.EventEnd},
+		} {
+			if value.value.IsNull() || value.value.IsUnknown() {
+				continue
+			}
+
+			parsed, err := time.Parse(time.RFC3339, value.value.ValueString())
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("territory_schedule"),
+					"Invalid Configuration",
+					fmt.Sprintf("`%s` must be an RFC 3339 time such as `2026-07-01T09:00:00Z`, got %q.", value.attribute, value.value.ValueString()),
+				)
+				continue
+			}
+			times[value.attribute] = parsed
+		}
+
+		publishStart, hasPublishStart := times["publish_start"]
+		eventStart, hasEventStart := times["event_start"]
+		eventEnd, hasEventEnd := times["event_end"]
+		if hasPublishStart && hasEventStart && eventStart.Before(publishStart) {
+			diags.AddAttributeError(
+				path.Root("territory_schedule"),
+				"Invalid Configuration",
+				"`event_start` must not be before `publish_start`.",
+			)
+		}
+		if hasEventStart && hasEventEnd && !eventEnd.After(eventStart) {
+			diags.AddAttributeError(
+				path.Root("territory_schedule"),
+				"Invalid Configuration",
+				"`event_end` must be after `event_start`.",
+			)
+		}
+	}
+	return diags
+}
+
+// validateAppEventLocalizations reports locales that are configured more than
+// once and a primary locale without a localization.
+func validateAppEventLocalizations(ctx context.Context, primaryLocale types.String, localizations types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if localizations.IsUnknown() {
+		return diags
+	}
+
+	var models []AppEventLocalizationModel
+	diags.Append(localizations.ElementsAs(ctx, &models, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	seen := map[string]bool{}
+	for _, model := range models {
+		if model.Locale.IsUnknown() {
+			// Can't tell whether this is the primary locale until apply.
+			return diags
+		}
+		locale := model.Locale.ValueString()
+		if seen[locale] {
+			diags.AddAttributeError(
+				path.Root("localization"),
+				"Invalid Configuration",
+				fmt.Sprintf("Locale %q is configured more than once.", locale),
+			)
+		}
+		seen[locale] = true
+	}
+
+	if !primaryLocale.IsNull() && !primaryLocale.IsUnknown() && !seen[primaryLocale.ValueString()] {
+		diags.AddAttributeError(
+			path.Root("localization"),
+			"Invalid Configuration",
+			fmt.Sprintf("A `localization` is required for the primary locale %q.", primaryLocale.ValueString()),
+		)
+	}
+	return diags
+}
+
+// ModifyPlan keeps the localization IDs when no locale is added or removed,
+// so that screenshots and video clips uploaded to them aren't replaced.
+func (r AppEventResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to keep when the resource is being created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state AppEventResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Localizations.IsUnknown() {
+		return
+	}
+
+	locales := func(localizations types.Set) ([]string, diag.Diagnostics) {
+		var models []AppEventLocalizationModel
+		diags := localizations.ElementsAs(ctx, &models, false)
+
+		locales := []string{}
+		for _, model := range models {
+			if model.Locale.IsUnknown() {
+				return nil, diags
+			}
+			locales = append(locales, model.Locale.ValueString())
+		}
+		slices.Sort(locales)
+		return locales, diags
+	}
+
+	planned, diags := locales(plan.Localizations)
+	resp.Diagnostics.Append(diags...)
+	current, diags := locales(state.Localizations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || planned == nil || !slices.Equal(planned, current) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("localization_ids"), state.LocalizationIDs)...)
+}
+
+// appEventScheduleKey identifies a territory schedule by its territories.
+func appEventScheduleKey(territories []string) string {
+	sorted := slices.Clone(territories)
+	slices.Sort(sorted)
+	return strings.Join(sorted, ",")
+}
+
+// appEventTime returns the configured time when Apple reports the same
+// instant, and Apple's time otherwise.
+func appEventTime(configured types.String, reported string) types.String {
+	if configured.IsNull() || configured.IsUnknown() {
+		return types.StringValue(reported)
+	}
+
+	want, err := time.Parse(time.RFC3339, configured.ValueString())
+	if err != nil {
+		return types.StringValue(reported)
+	}
+	got, err := time.Parse(time.RFC3339, reported)
+	if err != nil || !got.Equal(want) {
+		return types.StringValue(reported)
+	}
+	return configured
+}
+
+func (r *AppEventResource) populateState(ctx context.Context, data *AppEventResourceModel, event *events.Event, localizations []events.Localization) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	data.ID = types.StringValue(event.ID)
+	data.AppID = types.StringValue(event.AppID)
+	data.ReferenceName = types.StringValue(event.ReferenceName)
+	data.Badge = types.StringValue(event.Badge)
+	data.DeepLink = optionalString(event.DeepLink)
+	data.PurchaseRequirement = types.StringValue(event.PurchaseRequirement)
+	data.PrimaryLocale = types.StringValue(event.PrimaryLocale)
+	data.Priority = types.StringValue(event.Priority)
+	data.Purpose = types.StringValue(event.Purpose)
+	data.State = types.StringValue(event.State)
+
+	// Apple may report a time in a different offset to the one configured, so
+	// match each schedule to the configured one for the same territories.
+	configured := map[string]AppEventTerritoryScheduleModel{}
+	if !data.TerritorySchedules.IsNull() && !data.TerritorySchedules.IsUnknown() {
+		var models []AppEventTerritoryScheduleModel
+		diags.Append(data.TerritorySchedules.ElementsAs(ctx, &models, false)...)
+		for _, model := range models {
+			territories := []string{}
+			diags.Append(model.Territories.ElementsAs(ctx, &territories, false)...)
+			configured[appEventScheduleKey(territories)] = model
+		}
+	}
+
+	schedules := []AppEventTerritoryScheduleModel{}
+	for _, schedule := range event.TerritorySchedules {
+		var territories types.Set
+		territories, d = types.SetValueFrom(ctx, types.StringType, schedule.Territories)
+		diags.Append(d...)
+
+		prior := configured[appEventScheduleKey(schedule.Territories)]
+		schedules = append(schedules, AppEventTerritoryScheduleModel{
+			Territories:  territories,
+			PublishStart: appEventTime(prior.PublishStart, schedule.PublishStart),
+			EventStart:   appEventTime(prior.EventStart, schedule.EventStart),
+			EventEnd:     appEventTime(prior.EventEnd, schedule.EventEnd),
+		})
+	}
+	data.TerritorySchedules, d = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: appEventTerritoryScheduleAttrTypes}, schedules)
+	diags.Append(d...)
+
+	ids := map[string]string{}
+	models := []AppEventLocalizationModel{}
+	for _, localization := range localizations {
+		ids[localization.Locale] = localization.ID
+		models = append(models, AppEventLocalizationModel{
+			Locale:           types.StringValue(localization.Locale),
+			Name:             types.StringValue(localization.Name),
+			ShortDescription: types.StringValue(localization.ShortDescription),
+			LongDescription:  types.StringValue(localization.LongDescription),
+		})
+	}
+	data.LocalizationIDs, d = types.MapValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	data.Localizations, d = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: appEventLocalizationAttrTypes}, models)
+	diags.Append(d...)
+
+	return diags
+}
+
+func (r *AppEventResource) event(ctx context.Context, data *AppEventResourceModel) (events.Event, diag.Diagnostics) {
+	event := events.Event{
+		AppID:               data.AppID.ValueString(),
+		ReferenceName:       data.ReferenceName.ValueString(),
+		Badge:               data.Badge.ValueString(),
+		DeepLink:            data.DeepLink.ValueString(),
+		PurchaseRequirement: data.PurchaseRequirement.ValueString(),
+		PrimaryLocale:       data.PrimaryLocale.ValueString(),
+		Priority:            data.Priority.ValueString(),
+		Purpose:             data.Purpose.ValueString(),
+	}
+
+	var models []AppEventTerritoryScheduleModel
+	diags := data.TerritorySchedules.ElementsAs(ctx, &models, false)
+
+	for _, model := range models {
+		territories := []string{}
+		diags.Append(model.Territories.ElementsAs(ctx, &territories, false)...)
+
+		event.TerritorySchedules = append(event.TerritorySchedules, events.TerritorySchedule{
+			Territories:  territories,
+			PublishStart: model.PublishStart.ValueString(),
+			EventStart:   model.EventStart.ValueString(),
+			EventEnd:     model.EventEnd.ValueString(),
+		})
+	}
+	return event, diags
+}
+
+// syncLocalizations creates, modifies and deletes localizations, matched by
+// locale, and records the result, along with the event, in data.
+func (r *AppEventResource) syncLocalizations(ctx context.Context, data *AppEventResourceModel, event *events.Event) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var planned []AppEventLocalizationModel
+	diags.Append(data.Localizations.ElementsAs(ctx, &planned, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	existing, err := r.client.ListAppEventLocalizations(ctx, event.ID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list app event localizations, got error: %s", err))
+		return diags
+	}
+
+	current := map[string]events.Localization{}
+	for _, localization := range existing {
+		current[localization.Locale] = localization
+	}
+
+	for _, model := range planned {
+		localization := events.Localization{
+			EventID:          event.ID,
+			Locale:           model.Locale.ValueString(),
+			Name:             model.Name.ValueString(),
+			ShortDescription: model.ShortDescription.ValueString(),
+			LongDescription:  model.LongDescription.ValueString(),
+		}
+
+		match, ok := current[localization.Locale]
+		delete(current, localization.Locale)
+
+		switch {
+		case !ok:
+			_, err = r.client.CreateAppEventLocalization(ctx, localization)
+		case match.Name != localization.Name || match.ShortDescription != localization.ShortDescription || match.LongDescription != localization.LongDescription:
+			_, err = r.client.ModifyAppEventLocalization(ctx, match.ID, localization)
+		}
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update %s localization, got error: %s", localization.Locale, err))
+			return diags
+		}
+	}
+
+	for locale, localization := range current {
+		if err := r.client.DeleteAppEventLocalization(ctx, localization.ID); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete %s localization, got error: %s", locale, err))
+			return diags
+		}
+	}
+
+	existing, err = r.client.ListAppEventLocalizations(ctx, event.ID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list app event localizations, got error: %s", err))
+		return diags
+	}
+
+	diags.Append(r.populateState(ctx, data, event, existing)...)
+	return diags
+}
+
+func (r *AppEventResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AppEventResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, diags := r.event(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	event, err := r.client.CreateAppEvent(ctx, planned)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create app event, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an app event")
+
+	// Save the event before adding its localizations.
+	created := data
+	resp.Diagnostics.Append(r.populateState(ctx, &created, event, nil)...)
+	resp.Diagnostics.Append(saveCreated(ctx, &resp.State, &created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncLocalizations(ctx, &data, event)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppEventResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppEventResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	event, err := r.client.GetAppEvent(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app event, got error: %s", err))
+		return
+	}
+
+	localizations, err := r.client.ListAppEventLocalizations(ctx, event.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list app event localizations, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, event, localizations)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppEventResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AppEventResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, diags := r.event(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	event, err := r.client.ModifyAppEvent(ctx, data.ID.ValueString(), planned)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify app event, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified an app event")
+
+	resp.Diagnostics.Append(r.syncLocalizations(ctx, &data, event)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppEventResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AppEventResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAppEvent(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete app event, got error: %s", err))
+		return
+	}
+}
+
+func (r *AppEventResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,421 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/events"
+)
+
+type mockAppEventClient struct {
+	localizations []events.Localization
+	modifiedIDs   []string
+	deletedIDs    []string
+	nextID        int
+	createdEvent  events.Event
+	createErr     error
+	event         *events.Event
+}
+
+func (m *mockAppEventClient) CreateAppEvent(ctx context.Context, event events.Event) (*events.Event, error) {
+	m.createdEvent = event
+	event.ID = "event-id"
+	event.State = "DRAFT"
+	return &event, nil
+}
+
+func (m *mockAppEventClient) GetAppEvent(ctx context.Context, id string) (*events.Event, error) {
+	if m.event != nil {
+		return m.event, nil
+	}
+	return &events.Event{ID: id}, nil
+}
+
+func (m *mockAppEventClient) ModifyAppEvent(ctx context.Context, id string, event events.Event) (*events.Event, error) {
+	event.ID = id
+	event.State = "DRAFT"
+	return &event, nil
+}
+
+func (m *mockAppEventClient) DeleteAppEvent(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *mockAppEventClient) ListAppEventLocalizations(ctx context.Context, eventID string) ([]events.Localization, error) {
+	return m.localizations, nil
+}
+
+func (m *mockAppEventClient) CreateAppEventLocalization(ctx context.Context, localization events.Localization) (*events.Localization, error) {
+	if m.createErr != nil {
+		return nil, m.createErr
+	}
+	m.nextID++
+	localization.ID = fmt.Sprintf("localization-%d", m.nextID)
+	m.localizations = append(m.localizations, localization)
+	return &localization, nil
+}
+
+func (m *mockAppEventClient) ModifyAppEventLocalization(ctx context.Context, id string, localization events.Localization) (*events.Localization, error) {
+	m.modifiedIDs = append(m.modifiedIDs, id)
+	for i := range m.localizations {
+		if m.localizations[i].ID == id {
+			localization.ID = id
+			m.localizations[i] = localization
+		}
+	}
+	return &localization, nil
+}
+
+func (m *mockAppEventClient) DeleteAppEventLocalization(ctx context.Context, id string) error {
+	m.deletedIDs = append(m.deletedIDs, id)
+	for i := range m.localizations {
+		if m.localizations[i].ID == id {
+			m.localizations = append(m.localizations[:i], m.localizations[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func appEventResourceSchema() schema.Schema {
+	r := &AppEventResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+type appEventScheduleVal struct {
+	territories  []string
+	publishStart string
+	eventStart   string
+	eventEnd     string
+}
+
+type appEventLocalizationVal struct {
+	locale string
+	name   string
+}
+
+var defaultAppEventSchedule = appEventScheduleVal{
+	territories:  []string{"GBR", "USA"},
+	publishStart: "2026-06-24T09:00:00Z",
+	eventStart:   "2026-07-01T09:00:00Z",
+	eventEnd:     "2026-07-08T09:00:00Z",
+}
+
+func appEventVal(s schema.Schema, badge string, primaryLocale string, localizationIDs tftypes.Value, schedules []appEventScheduleVal, localizations ...appEventLocalizationVal) tftypes.Value {
+	objectType := s.Type().TerraformType(context.Background()).(tftypes.Object)
+	scheduleType := objectType.AttributeTypes["territory_schedule"].(tftypes.Set).ElementType
+	localizationType := objectType.AttributeTypes["localization"].(tftypes.Set).ElementType
+
+	scheduleVals := []tftypes.Value{}
+	for _, schedule := range schedules {
+		territories := []tftypes.Value{}
+		for _, territory := range schedule.territories {
+			territories = append(territories, tftypes.NewValue(tftypes.String, territory))
+		}
+		scheduleVals = append(scheduleVals, tftypes.NewValue(scheduleType, map[string]tftypes.Value{
+			"territories":   tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, territories),
+			"publish_start": tftypes.NewValue(tftypes.String, schedule.publishStart),
+			"event_start":   tftypes.NewValue(tftypes.String, schedule.eventStart),
+			"event_end":     tftypes.NewValue(tftypes.String, schedule.eventEnd),
+		}))
+	}
+
+	localizationVals := []tftypes.Value{}
+	for _, localization := range localizations {
+		localizationVals = append(localizationVals, tftypes.NewValue(localizationType, map[string]tftypes.Value{
+			"locale":            tftypes.NewValue(tftypes.String, localization.locale),
+			"name":              tftypes.NewValue(tftypes.String, localization.name),
+			"short_description": tftypes.NewValue(tftypes.String, "Race your friends."),
+			"long_description":  tftypes.NewValue(tftypes.String, "Race your friends around the world."),
+		}))
+	}
+
+	return tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":                   tftypes.NewValue(tftypes.String, "event-id"),
+		"app_id":               tftypes.NewValue(tftypes.String, "app-id"),
+		"reference_name":       tftypes.NewValue(tftypes.String, "Summer race"),
+		"badge":                tftypes.NewValue(tftypes.String, badge),
+		"deep_link":            tftypes.NewValue(tftypes.String, nil),
+		"purchase_requirement": tftypes.NewValue(tftypes.String, "NO_COST_ASSOCIATED"),
+		"primary_locale":       tftypes.NewValue(tftypes.String, primaryLocale),
+		"priority":             tftypes.NewValue(tftypes.String, "NORMAL"),
+		"purpose":              tftypes.NewValue(tftypes.String, "APPROPRIATE_FOR_ALL_USERS"),
+		"state":                tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"localization_ids":     localizationIDs,
+		"territory_schedule":   tftypes.NewValue(objectType.AttributeTypes["territory_schedule"], scheduleVals),
+		"localization":         tftypes.NewValue(objectType.AttributeTypes["localization"], localizationVals),
+	})
+}
+
+func TestAppEventResource_Create_SendsScheduleAndCreatesLocalizations(t *testing.T) {
+	client := &mockAppEventClient{}
+	r := &AppEventResource{client: client}
+
+	s := appEventResourceSchema()
+	unknownIDs := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue)
+	planVal := appEventVal(s, "CHALLENGE", "en-GB", unknownIDs, []appEventScheduleVal{defaultAppEventSchedule},
+		appEventLocalizationVal{locale: "en-GB", name: "Summer race"},
+		appEventLocalizationVal{locale: "fr-FR", name: "Course d'été"},
+	)
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.createdEvent.TerritorySchedules) != 1 || client.createdEvent.TerritorySchedules[0].EventStart != "2026-07-01T09:00:00Z" {
+		t.Errorf("expected the territory schedule to be sent, got %v", client.createdEvent.TerritorySchedules)
+	}
+
+	var data AppEventResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.State.ValueString() != "DRAFT" {
+		t.Errorf("expected state 'DRAFT', got %q", data.State.ValueString())
+	}
+
+	ids := map[string]string{}
+	data.LocalizationIDs.ElementsAs(context.Background(), &ids, false)
+	if len(ids) != 2 || ids["en-GB"] == "" || ids["fr-FR"] == "" {
+		t.Errorf("expected localizations for en-GB and fr-FR, got %v", ids)
+	}
+}
+
+func TestAppEventResource_Create_SavesEventWhenLocalizationsFail(t *testing.T) {
+	client := &mockAppEventClient{createErr: fmt.Errorf("service unavailable")}
+	r := &AppEventResource{client: client}
+
+	s := appEventResourceSchema()
+	unknownIDs := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue)
+	planVal := appEventVal(s, "CHALLENGE", "en-GB", unknownIDs, []appEventScheduleVal{defaultAppEventSchedule},
+		appEventLocalizationVal{locale: "en-GB", name: "Summer race"},
+	)
+
+	state := createSavedState(t, r, s, planVal, "event-id")
+
+	var data AppEventResourceModel
+	state.Get(context.Background(), &data)
+
+	if len(data.LocalizationIDs.Elements()) != 0 {
+		t.Errorf("expected no localization IDs to be saved, got %v", data.LocalizationIDs)
+	}
+}
+
+func TestAppEventResource_Read_KeepsConfiguredTimeOffsets(t *testing.T) {
+	client := &mockAppEventClient{
+		event: &events.Event{
+			ID: "event-id", AppID: "app-id", ReferenceName: "Summer race", Badge: "CHALLENGE",
+			PurchaseRequirement: "NO_COST_ASSOCIATED", PrimaryLocale: "en-GB", Priority: "NORMAL",
+			Purpose: "APPROPRIATE_FOR_ALL_USERS", State: "DRAFT",
+			TerritorySchedules: []events.TerritorySchedule{{
+				Territories:  []string{"USA", "GBR"},
+				PublishStart: "2026-06-24T09:00:00Z",
+				EventStart:   "2026-07-01T09:00:00Z",
+				EventEnd:     "2026-07-09T09:00:00Z",
+			}},
+		},
+	}
+	r := &AppEventResource{client: client}
+
+	s := appEventResourceSchema()
+	ids := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{})
+	stateVal := appEventVal(s, "CHALLENGE", "en-GB", ids, []appEventScheduleVal{{
+		territories:  []string{"GBR", "USA"},
+		publishStart: "2026-06-24T10:00:00+01:00",
+		eventStart:   "2026-07-01T10:00:00+01:00",
+		eventEnd:     "2026-07-08T10:00:00+01:00",
+	}})
+
+	req := resource.ReadRequest{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+	resp := &resource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+
+	var data AppEventResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	var schedules []AppEventTerritoryScheduleModel
+	data.TerritorySchedules.ElementsAs(context.Background(), &schedules, false)
+	if len(schedules) != 1 {
+		t.Fatalf("expected 1 territory schedule, got %d", len(schedules))
+	}
+	if schedules[0].PublishStart.ValueString() != "2026-06-24T10:00:00+01:00" || schedules[0].EventStart.ValueString() != "2026-07-01T10:00:00+01:00" {
+		t.Errorf("expected the configured times to be kept, got %q and %q", schedules[0].PublishStart.ValueString(), schedules[0].EventStart.ValueString())
+	}
+	if schedules[0].EventEnd.ValueString() != "2026-07-09T09:00:00Z" {
+		t.Errorf("expected the changed end time to be reported, got %q", schedules[0].EventEnd.ValueString())
+	}
+}
+
+func TestAppEventResource_Update_SyncsLocalizations(t *testing.T) {
+	client := &mockAppEventClient{
+		localizations: []events.Localization{
+			{ID: "localization-en", EventID: "event-id", Locale: "en-GB", Name: "Summer race", ShortDescription: "Race your friends.", LongDescription: "Race your friends around the world."},
+			{ID: "localization-de", EventID: "event-id", Locale: "de-DE", Name: "Sommerrennen", ShortDescription: "Race your friends.", LongDescription: "Race your friends around the world."},
+		},
+	}
+	r := &AppEventResource{client: client}
+
+	s := appEventResourceSchema()
+	unknownIDs := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue)
+	planVal := appEventVal(s, "CHALLENGE", "en-GB", unknownIDs, []appEventScheduleVal{defaultAppEventSchedule},
+		appEventLocalizationVal{locale: "en-GB", name: "Summer sprint"},
+		appEventLocalizationVal{locale: "fr-FR", name: "Course d'été"},
+	)
+
+	req := resource.UpdateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Update(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.modifiedIDs) != 1 || client.modifiedIDs[0] != "localization-en" {
+		t.Errorf("expected the en-GB localization to be modified, got %v", client.modifiedIDs)
+	}
+	if len(client.deletedIDs) != 1 || client.deletedIDs[0] != "localization-de" {
+		t.Errorf("expected the de-DE localization to be deleted, got %v", client.deletedIDs)
+	}
+
+	var data AppEventResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	ids := map[string]string{}
+	data.LocalizationIDs.ElementsAs(context.Background(), &ids, false)
+	if len(ids) != 2 || ids["en-GB"] != "localization-en" || ids["fr-FR"] != "localization-1" {
+		t.Errorf("expected en-GB to be kept and fr-FR to be created, got %v", ids)
+	}
+}
+
+func TestAppEventResource_ModifyPlan_KeepsLocalizationIDs(t *testing.T) {
+	r := AppEventResource{}
+
+	s := appEventResourceSchema()
+	ids := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+		"en-GB": tftypes.NewValue(tftypes.String, "localization-en"),
+	})
+	unknownIDs := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue)
+
+	for _, tc := range []struct {
+		name          string
+		localizations []appEventLocalizationVal
+		expectKnown   bool
+	}{
+		{name: "renamed", localizations: []appEventLocalizationVal{{locale: "en-GB", name: "Summer sprint"}}, expectKnown: true},
+		{name: "locale added", localizations: []appEventLocalizationVal{{locale: "en-GB", name: "Summer race"}, {locale: "fr-FR", name: "Course d'été"}}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			stateVal := appEventVal(s, "CHALLENGE", "en-GB", ids, []appEventScheduleVal{defaultAppEventSchedule}, appEventLocalizationVal{locale: "en-GB", name: "Summer race"})
+			planVal := appEventVal(s, "CHALLENGE", "en-GB", unknownIDs, []appEventScheduleVal{defaultAppEventSchedule}, tc.localizations...)
+
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: s, Raw: stateVal},
+				Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+			}
+			resp := &resource.ModifyPlanResponse{
+				Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+			}
+
+			r.ModifyPlan(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+			}
+
+			var data AppEventResourceModel
+			resp.Plan.Get(context.Background(), &data)
+
+			if data.LocalizationIDs.IsUnknown() == tc.expectKnown {
+				t.Errorf("expected known localization IDs=%t, got %v", tc.expectKnown, data.LocalizationIDs)
+			}
+		})
+	}
+}
+
+func TestAppEventResource_ValidateConfig(t *testing.T) {
+	r := AppEventResource{}
+	s := appEventResourceSchema()
+	nullIDs := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil)
+	english := appEventLocalizationVal{locale: "en-GB", name: "Summer race"}
+
+	for _, tc := range []struct {
+		name          string
+		badge         string
+		primaryLocale string
+		schedules     []appEventScheduleVal
+		localizations []appEventLocalizationVal
+		expectError   bool
+	}{
+		{name: "valid", badge: "CHALLENGE", primaryLocale: "en-GB", schedules: []appEventScheduleVal{defaultAppEventSchedule}, localizations: []appEventLocalizationVal{english}},
+		{name: "unknown badge", badge: "PARTY", primaryLocale: "en-GB", schedules: []appEventScheduleVal{defaultAppEventSchedule}, localizations: []appEventLocalizationVal{english}, expectError: true},
+		{name: "no schedule", badge: "CHALLENGE", primaryLocale: "en-GB", localizations: []appEventLocalizationVal{english}, expectError: true},
+		{
+			name: "invalid territory", badge: "CHALLENGE", primaryLocale: "en-GB",
+			schedules:     []appEventScheduleVal{{territories: []string{"GB"}, publishStart: "2026-06-24T09:00:00Z", eventStart: "2026-07-01T09:00:00Z", eventEnd: "2026-07-08T09:00:00Z"}},
+			localizations: []appEventLocalizationVal{english}, expectError: true,
+		},
+		{
+			name: "invalid time", badge: "CHALLENGE", primaryLocale: "en-GB",
+			schedules:     []appEventScheduleVal{{territories: []string{"GBR"}, publishStart: "2026-06-24", eventStart: "2026-07-01T09:00:00Z", eventEnd: "2026-07-08T09:00:00Z"}},
+			localizations: []appEventLocalizationVal{english}, expectError: true,
+		},
+		{
+			name: "starts before published", badge: "CHALLENGE", primaryLocale: "en-GB",
+			schedules:     []appEventScheduleVal{{territories: []string{"GBR"}, publishStart: "2026-07-02T09:00:00Z", eventStart: "2026-07-01T09:00:00Z", eventEnd: "2026-07-08T09:00:00Z"}},
+			localizations: []appEventLocalizationVal{english}, expectError: true,
+		},
+		{
+			name: "ends before start", badge: "CHALLENGE", primaryLocale: "en-GB",
+			schedules:     []appEventScheduleVal{{territories: []string{"GBR"}, publishStart: "2026-06-24T09:00:00Z", eventStart: "2026-07-01T09:00:00Z", eventEnd: "2026-07-01T09:00:00Z"}},
+			localizations: []appEventLocalizationVal{english}, expectError: true,
+		},
+		{name: "primary locale not localized", badge: "CHALLENGE", primaryLocale: "fr-FR", schedules: []appEventScheduleVal{defaultAppEventSchedule}, localizations: []appEventLocalizationVal{english}, expectError: true},
+		{
+			name: "duplicate locale", badge: "CHALLENGE", primaryLocale: "en-GB", schedules: []appEventScheduleVal{defaultAppEventSchedule},
+			localizations: []appEventLocalizationVal{english, {locale: "en-GB", name: "Summer sprint"}}, expectError: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: appEventVal(s, tc.badge, tc.primaryLocale, nullIDs, tc.schedules, tc.localizations...)},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error=%t, got %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
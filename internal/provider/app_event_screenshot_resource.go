@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/events"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AppEventScreenshotResource{}
+var _ resource.ResourceWithValidateConfig = &AppEventScreenshotResource{}
+var _ resource.ResourceWithModifyPlan = &AppEventScreenshotResource{}
+
+// appEventAssetTypes are where an event image or video is shown on the App Store.
+var appEventAssetTypes = []string{"EVENT_CARD", "EVENT_DETAILS_PAGE"}
+
+type appEventScreenshotClient interface {
+	ReserveAppEventScreenshot(ctx context.Context, localizationID string, assetType string, fileName string, fileSize int64) (*events.Screenshot, error)
+	CommitAppEventScreenshot(ctx context.Context, id string, checksum string) (*events.Screenshot, error)
+	GetAppEventScreenshot(ctx context.Context, id string) (*events.Screenshot, error)
+	DeleteAppEventScreenshot(ctx context.Context, id string) error
+}
+
+func NewAppEventScreenshotResource() resource.Resource {
+	return &AppEventScreenshotResource{}
+}
+
+// AppEventScreenshotResource defines the resource implementation.
+type AppEventScreenshotResource struct {
+	client appEventScreenshotClient
+}
+
+// AppEventScreenshotResourceModel describes the resource data model.
+type AppEventScreenshotResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	LocalizationID     types.String `tfsdk:"localization_id"`
+	AssetType          types.String `tfsdk:"asset_type"`
+	FilePath           types.String `tfsdk:"file_path"`
+	FileName           types.String `tfsdk:"file_name"`
+	SourceFileChecksum types.String `tfsdk:"source_file_checksum"`
+	AssetDeliveryState types.String `tfsdk:"asset_delivery_state"`
+}
+
+func (r *AppEventScreenshotResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_event_screenshot"
+}
+
+func (r *AppEventScreenshotResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uploads an image from a local file to an in-app event localization. The image is replaced whenever the MD5 checksum of the local file differs from the checksum stored by Apple.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the image.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"localization_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the event localization to upload the image to, from `localization_ids` of `appstoreconnect_app_event`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"asset_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Where the image is shown: `EVENT_CARD` or `EVENT_DETAILS_PAGE`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The path to the image file to upload.",
+			},
+			"file_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The file name Apple recorded for the upload.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_file_checksum": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The MD5 checksum of the uploaded file, as stored by Apple.",
+			},
+			"asset_delivery_state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The processing state of the uploaded asset (e.g. `UPLOAD_COMPLETE`, `COMPLETE`, `FAILED`).",
+			},
+		},
+	}
+}
+
+func (r *AppEventScreenshotResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(appEventScreenshotClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected appEventScreenshotClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r AppEventScreenshotResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AppEventScreenshotResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.AssetType.IsNull() && !data.AssetType.IsUnknown() && !slices.Contains(appEventAssetTypes, data.AssetType.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("asset_type"),
+			"Invalid Configuration",
+			fmt.Sprintf("`asset_type` must be one of %s, got %q.", strings.Join(appEventAssetTypes, ", "), data.AssetType.ValueString()),
+		)
+	}
+}
+
+func (r AppEventScreenshotResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan AppEventScreenshotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.FilePath.IsUnknown() {
+		return
+	}
+
+	_, checksum, err := readAsset(plan.FilePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file_path"),
+			"Unable to read app event screenshot",
+			fmt.Sprintf("Unable to read %q, got error: %s", plan.FilePath.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_file_checksum"), checksum)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state AppEventScreenshotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	// Uploaded assets cannot be modified, so a different file means a new image.
+	if state.SourceFileChecksum.ValueString() != checksum {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("source_file_checksum"))
+	}
+}
+
+func (r *AppEventScreenshotResource) populateState(data *AppEventScreenshotResourceModel, screenshot *events.Screenshot) {
+	data.ID = types.StringValue(screenshot.ID)
+	data.FileName = types.StringValue(screenshot.FileName)
+	data.SourceFileChecksum = types.StringValue(screenshot.SourceFileChecksum)
+	data.AssetDeliveryState = types.StringValue(screenshot.AssetDeliveryState)
+}
+
+func (r *AppEventScreenshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AppEventScreenshotResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	file, checksum, err := readAsset(data.FilePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("File Error", fmt.Sprintf("Unable to read app event screenshot, got error: %s", err))
+		return
+	}
+
+	reservation, err := r.client.ReserveAppEventScreenshot(
+		ctx,
+		data.LocalizationID.ValueString(),
+		data.AssetType.ValueString(),
+		filepath.Base(data.FilePath.ValueString()),
+		int64(len(file)),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reserve app event screenshot, got error: %s", err))
+		return
+	}
+
+	err = uploadAsset(ctx, reservation.UploadOperations, file)
+	if err != nil {
+		resp.Diagnostics.AddError("Upload Error", fmt.Sprintf("Unable to upload app event screenshot, got error: %s", err))
+		return
+	}
+
+	screenshot, err := r.client.CommitAppEventScreenshot(ctx, reservation.ID, checksum)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to commit app event screenshot, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "uploaded an app event screenshot")
+
+	r.populateState(&data, screenshot)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppEventScreenshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppEventScreenshotResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	screenshot, err := r.client.GetAppEventScreenshot(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app event screenshot, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, screenshot)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppEventScreenshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AppEventScreenshotResourceModel
+
+	// A changed file forces replacement, so only a moved file path reaches here.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	screenshot, err := r.client.GetAppEventScreenshot(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app event screenshot, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, screenshot)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppEventScreenshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AppEventScreenshotResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAppEventScreenshot(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete app event screenshot, got error: %s", err))
+		return
+	}
+}
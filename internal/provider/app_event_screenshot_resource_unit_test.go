@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/events"
+)
+
+type mockAppEventScreenshotClient struct {
+	reservedLocalizationID string
+	reservedAssetType      string
+	reservedName           string
+	reservedSize           int64
+}
+
+func (m *mockAppEventScreenshotClient) ReserveAppEventScreenshot(ctx context.Context, localizationID string, assetType string, fileName string, fileSize int64) (*events.Screenshot, error) {
+	m.reservedLocalizationID = localizationID
+	m.reservedAssetType = assetType
+	m.reservedName = fileName
+	m.reservedSize = fileSize
+	return &events.Screenshot{ID: "screenshot-id"}, nil
+}
+
+func (m *mockAppEventScreenshotClient) CommitAppEventScreenshot(ctx context.Context, id string, checksum string) (*events.Screenshot, error) {
+	return &events.Screenshot{ID: id, FileName: "home.png", SourceFileChecksum: checksum, AssetDeliveryState: "UPLOAD_COMPLETE"}, nil
+}
+
+func (m *mockAppEventScreenshotClient) GetAppEventScreenshot(ctx context.Context, id string) (*events.Screenshot, error) {
+	return &events.Screenshot{ID: id, FileName: "home.png", SourceFileChecksum: "5eb63bbbe01eeed093cb22bb8f5acdc3", AssetDeliveryState: "COMPLETE"}, nil
+}
+
+func (m *mockAppEventScreenshotClient) DeleteAppEventScreenshot(ctx context.Context, id string) error {
+	return nil
+}
+
+func appEventScreenshotResourceSchema() schema.Schema {
+	r := &AppEventScreenshotResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func appEventScreenshotVal(s schema.Schema, filePath string, assetType string, checksum interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                   tftypes.NewValue(tftypes.String, "screenshot-id"),
+		"localization_id":      tftypes.NewValue(tftypes.String, "localization-id"),
+		"asset_type":           tftypes.NewValue(tftypes.String, assetType),
+		"file_path":            tftypes.NewValue(tftypes.String, filePath),
+		"file_name":            tftypes.NewValue(tftypes.String, nil),
+		"source_file_checksum": tftypes.NewValue(tftypes.String, checksum),
+		"asset_delivery_state": tftypes.NewValue(tftypes.String, nil),
+	})
+}
+
+func TestAppEventScreenshotResource_Create_ReservesUploadsAndCommits(t *testing.T) {
+	filePath := writeScreenshotFile(t, "hello world")
+
+	client := &mockAppEventScreenshotClient{}
+	r := &AppEventScreenshotResource{client: client}
+
+	s := appEventScreenshotResourceSchema()
+	planVal := appEventScreenshotVal(s, filePath, "EVENT_CARD", "5eb63bbbe01eeed093cb22bb8f5acdc3")
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if client.reservedLocalizationID != "localization-id" || client.reservedAssetType != "EVENT_CARD" {
+		t.Errorf("expected reservation for the EVENT_CARD of 'localization-id', got %q of %q", client.reservedAssetType, client.reservedLocalizationID)
+	}
+	if client.reservedName != "home.png" || client.reservedSize != 11 {
+		t.Errorf("expected reservation for 'home.png' of 11 bytes, got %q of %d bytes", client.reservedName, client.reservedSize)
+	}
+
+	var data AppEventScreenshotResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.SourceFileChecksum.ValueString() != "5eb63bbbe01eeed093cb22bb8f5acdc3" {
+		t.Errorf("expected the file's MD5 checksum to be committed, got %q", data.SourceFileChecksum.ValueString())
+	}
+	if data.AssetDeliveryState.ValueString() != "UPLOAD_COMPLETE" {
+		t.Errorf("expected AssetDeliveryState 'UPLOAD_COMPLETE', got %q", data.AssetDeliveryState.ValueString())
+	}
+}
+
+func TestAppEventScreenshotResource_Update_ReadsDeliveryState(t *testing.T) {
+	filePath := writeScreenshotFile(t, "hello world")
+
+	r := &AppEventScreenshotResource{client: &mockAppEventScreenshotClient{}}
+
+	s := appEventScreenshotResourceSchema()
+	stateVal := appEventScreenshotVal(s, "old/home.png", "EVENT_CARD", "5eb63bbbe01eeed093cb22bb8f5acdc3")
+	planVal := tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                   tftypes.NewValue(tftypes.String, "screenshot-id"),
+		"localization_id":      tftypes.NewValue(tftypes.String, "localization-id"),
+		"asset_type":           tftypes.NewValue(tftypes.String, "EVENT_CARD"),
+		"file_path":            tftypes.NewValue(tftypes.String, filePath),
+		"file_name":            tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"source_file_checksum": tftypes.NewValue(tftypes.String, "5eb63bbbe01eeed093cb22bb8f5acdc3"),
+		"asset_delivery_state": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+
+	req := resource.UpdateRequest{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Update(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+
+	var data AppEventScreenshotResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.AssetDeliveryState.ValueString() != "COMPLETE" {
+		t.Errorf("expected AssetDeliveryState 'COMPLETE', got %q", data.AssetDeliveryState.ValueString())
+	}
+	if data.FilePath.ValueString() != filePath {
+		t.Errorf("expected FilePath %q, got %q", filePath, data.FilePath.ValueString())
+	}
+}
+
+func TestAppEventScreenshotResource_ModifyPlan_RequiresReplaceWhenChecksumDiffers(t *testing.T) {
+	filePath := writeScreenshotFile(t, "hello world")
+
+	r := AppEventScreenshotResource{}
+
+	s := appEventScreenshotResourceSchema()
+	stateVal := appEventScreenshotVal(s, filePath, "EVENT_CARD", "checksum-from-apple")
+	planVal := appEventScreenshotVal(s, filePath, "EVENT_CARD", tftypes.UnknownValue)
+
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.ModifyPlanResponse{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+
+	r.ModifyPlan(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(resp.RequiresReplace) != 1 || !resp.RequiresReplace[0].Equal(path.Root("source_file_checksum")) {
+		t.Errorf("expected replacement on source_file_checksum, got %v", resp.RequiresReplace)
+	}
+}
+
+func TestAppEventScreenshotResource_ValidateConfig(t *testing.T) {
+	r := AppEventScreenshotResource{}
+	s := appEventScreenshotResourceSchema()
+
+	for _, tc := range []struct {
+		assetType   string
+		expectError bool
+	}{
+		{assetType: "EVENT_CARD"},
+		{assetType: "EVENT_DETAILS_PAGE"},
+		{assetType: "APP_ICON", expectError: true},
+	} {
+		t.Run(tc.assetType, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: appEventScreenshotVal(s, "home.png", tc.assetType, nil)},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error=%t, got %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
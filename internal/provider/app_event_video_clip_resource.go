@@ -0,0 +1,305 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/events"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AppEventVideoClipResource{}
+var _ resource.ResourceWithValidateConfig = &AppEventVideoClipResource{}
+var _ resource.ResourceWithModifyPlan = &AppEventVideoClipResource{}
+
+type appEventVideoClipClient interface {
+	ReserveAppEventVideoClip(ctx context.Context, localizationID string, assetType string, fileName string, fileSize int64) (*events.VideoClip, error)
+	CommitAppEventVideoClip(ctx context.Context, id string, checksum string) (*events.VideoClip, error)
+	ModifyAppEventVideoClip(ctx context.Context, id string, clip events.VideoClip) (*events.VideoClip, error)
+	GetAppEventVideoClip(ctx context.Context, id string) (*events.VideoClip, error)
+	DeleteAppEventVideoClip(ctx context.Context, id string) error
+}
+
+func NewAppEventVideoClipResource() resource.Resource {
+	return &AppEventVideoClipResource{}
+}
+
+// AppEventVideoClipResource defines the resource implementation.
+type AppEventVideoClipResource struct {
+	client appEventVideoClipClient
+}
+
+// AppEventVideoClipResourceModel describes the resource data model.
+type AppEventVideoClipResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	LocalizationID       types.String `tfsdk:"localization_id"`
+	AssetType            types.String `tfsdk:"asset_type"`
+	FilePath             types.String `tfsdk:"file_path"`
+	PreviewFrameTimeCode types.String `tfsdk:"preview_frame_time_code"`
+	FileName             types.String `tfsdk:"file_name"`
+	SourceFileChecksum   types.String `tfsdk:"source_file_checksum"`
+	AssetDeliveryState   types.String `tfsdk:"asset_delivery_state"`
+}
+
+func (r *AppEventVideoClipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_event_video_clip"
+}
+
+func (r *AppEventVideoClipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uploads a video from a local file to an in-app event localization. The video is replaced whenever the MD5 checksum of the local file differs from the checksum stored by Apple.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the video.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"localization_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the event localization to upload the video to, from `localization_ids` of `appstoreconnect_app_event`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"asset_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Where the video is shown: `EVENT_CARD` or `EVENT_DETAILS_PAGE`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The path to the video file to upload.",
+			},
+			"preview_frame_time_code": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The time code of the frame used as the poster image (e.g. `00:00:05:00`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"file_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The file name Apple recorded for the upload.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_file_checksum": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The MD5 checksum of the uploaded file, as stored by Apple.",
+			},
+			"asset_delivery_state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The processing state of the uploaded asset (e.g. `UPLOAD_COMPLETE`, `COMPLETE`, `FAILED`).",
+			},
+		},
+	}
+}
+
+func (r *AppEventVideoClipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(appEventVideoClipClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected appEventVideoClipClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r AppEventVideoClipResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AppEventVideoClipResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.AssetType.IsNull() && !data.AssetType.IsUnknown() && !slices.Contains(appEventAssetTypes, data.AssetType.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("asset_type"),
+			"Invalid Configuration",
+			fmt.Sprintf("`asset_type` must be one of %s, got %q.", strings.Join(appEventAssetTypes, ", "), data.AssetType.ValueString()),
+		)
+	}
+}
+
+func (r AppEventVideoClipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan AppEventVideoClipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.FilePath.IsUnknown() {
+		return
+	}
+
+	_, checksum, err := readAsset(plan.FilePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file_path"),
+			"Unable to read app event video clip",
+			fmt.Sprintf("Unable to read %q, got error: %s", plan.FilePath.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_file_checksum"), checksum)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state AppEventVideoClipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	// Uploaded assets cannot be modified, so a different file means a new video.
+	if state.SourceFileChecksum.ValueString() != checksum {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("source_file_checksum"))
+	}
+}
+
+func (r *AppEventVideoClipResource) populateState(data *AppEventVideoClipResourceModel, clip *events.VideoClip) {
+	data.ID = types.StringValue(clip.ID)
+	data.FileName = types.StringValue(clip.FileName)
+	data.SourceFileChecksum = types.StringValue(clip.SourceFileChecksum)
+	data.AssetDeliveryState = types.StringValue(clip.AssetDeliveryState)
+	data.PreviewFrameTimeCode = types.StringValue(clip.PreviewFrameTimeCode)
+}
+
+func (r *AppEventVideoClipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AppEventVideoClipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	file, checksum, err := readAsset(data.FilePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("File Error", fmt.Sprintf("Unable to read app event video clip, got error: %s", err))
+		return
+	}
+
+	reservation, err := r.client.ReserveAppEventVideoClip(
+		ctx,
+		data.LocalizationID.ValueString(),
+		data.AssetType.ValueString(),
+		filepath.Base(data.FilePath.ValueString()),
+		int64(len(file)),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reserve app event video clip, got error: %s", err))
+		return
+	}
+
+	err = uploadAsset(ctx, reservation.UploadOperations, file)
+	if err != nil {
+		resp.Diagnostics.AddError("Upload Error", fmt.Sprintf("Unable to upload app event video clip, got error: %s", err))
+		return
+	}
+
+	clip, err := r.client.CommitAppEventVideoClip(ctx, reservation.ID, checksum)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to commit app event video clip, got error: %s", err))
+		return
+	}
+
+	if !data.PreviewFrameTimeCode.IsUnknown() {
+		clip, err = r.client.ModifyAppEventVideoClip(ctx, clip.ID, events.VideoClip{
+			PreviewFrameTimeCode: data.PreviewFrameTimeCode.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set app event video clip poster frame, got error: %s", err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "uploaded an app event video clip")
+
+	r.populateState(&data, clip)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppEventVideoClipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppEventVideoClipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clip, err := r.client.GetAppEventVideoClip(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app event video clip, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, clip)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppEventVideoClipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AppEventVideoClipResourceModel
+
+	// A changed file forces replacement, so only the poster frame can be updated in place.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clip, err := r.client.ModifyAppEventVideoClip(ctx, data.ID.ValueString(), events.VideoClip{
+		PreviewFrameTimeCode: data.PreviewFrameTimeCode.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify app event video clip, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, clip)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppEventVideoClipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AppEventVideoClipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAppEventVideoClip(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete app event video clip, got error: %s", err))
+		return
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/events"
+)
+
+type mockAppEventVideoClipClient struct {
+	modifyFn func(ctx context.Context, id string, clip events.VideoClip) (*events.VideoClip, error)
+}
+
+func (m *mockAppEventVideoClipClient) ReserveAppEventVideoClip(ctx context.Context, localizationID string, assetType string, fileName string, fileSize int64) (*events.VideoClip, error) {
+	return &events.VideoClip{ID: "clip-id", FileName: fileName}, nil
+}
+
+func (m *mockAppEventVideoClipClient) CommitAppEventVideoClip(ctx context.Context, id string, checksum string) (*events.VideoClip, error) {
+	return &events.VideoClip{ID: id, FileName: "clip.mp4", SourceFileChecksum: checksum, AssetDeliveryState: "UPLOAD_COMPLETE", PreviewFrameTimeCode: "00:00:00:00"}, nil
+}
+
+func (m *mockAppEventVideoClipClient) ModifyAppEventVideoClip(ctx context.Context, id string, clip events.VideoClip) (*events.VideoClip, error) {
+	if m.modifyFn != nil {
+		return m.modifyFn(ctx, id, clip)
+	}
+	return &events.VideoClip{ID: id}, nil
+}
+
+func (m *mockAppEventVideoClipClient) GetAppEventVideoClip(ctx context.Context, id string) (*events.VideoClip, error) {
+	return &events.VideoClip{ID: id}, nil
+}
+
+func (m *mockAppEventVideoClipClient) DeleteAppEventVideoClip(ctx context.Context, id string) error {
+	return nil
+}
+
+func appEventVideoClipResourceSchema() schema.Schema {
+	r := &AppEventVideoClipResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func appEventVideoClipVal(s schema.Schema, filePath string, frameTimeCode interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                      tftypes.NewValue(tftypes.String, nil),
+		"localization_id":         tftypes.NewValue(tftypes.String, "localization-id"),
+		"asset_type":              tftypes.NewValue(tftypes.String, "EVENT_DETAILS_PAGE"),
+		"file_path":               tftypes.NewValue(tftypes.String, filePath),
+		"preview_frame_time_code": tftypes.NewValue(tftypes.String, frameTimeCode),
+		"file_name":               tftypes.NewValue(tftypes.String, nil),
+		"source_file_checksum":    tftypes.NewValue(tftypes.String, "5eb63bbbe01eeed093cb22bb8f5acdc3"),
+		"asset_delivery_state":    tftypes.NewValue(tftypes.String, nil),
+	})
+}
+
+func TestAppEventVideoClipResource_Create_SetsPosterFrameWhenConfigured(t *testing.T) {
+	filePath := writeScreenshotFile(t, "hello world")
+
+	var capturedTimeCode string
+	r := &AppEventVideoClipResource{
+		client: &mockAppEventVideoClipClient{
+			modifyFn: func(ctx context.Context, id string, clip events.VideoClip) (*events.VideoClip, error) {
+				capturedTimeCode = clip.PreviewFrameTimeCode
+				return &events.VideoClip{ID: id, FileName: "clip.mp4", SourceFileChecksum: "5eb63bbbe01eeed093cb22bb8f5acdc3", PreviewFrameTimeCode: clip.PreviewFrameTimeCode}, nil
+			},
+		},
+	}
+
+	s := appEventVideoClipResourceSchema()
+	planVal := appEventVideoClipVal(s, filePath, "00:00:05:00")
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if capturedTimeCode != "00:00:05:00" {
+		t.Errorf("expected ModifyAppEventVideoClip called with '00:00:05:00', got %q", capturedTimeCode)
+	}
+
+	var data AppEventVideoClipResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.PreviewFrameTimeCode.ValueString() != "00:00:05:00" {
+		t.Errorf("expected PreviewFrameTimeCode '00:00:05:00', got %q", data.PreviewFrameTimeCode.ValueString())
+	}
+}
+
+func TestAppEventVideoClipResource_Create_KeepsDefaultPosterFrame(t *testing.T) {
+	filePath := writeScreenshotFile(t, "hello world")
+
+	r := &AppEventVideoClipResource{
+		client: &mockAppEventVideoClipClient{
+			modifyFn: func(ctx context.Context, id string, clip events.VideoClip) (*events.VideoClip, error) {
+				t.Errorf("expected ModifyAppEventVideoClip not to be called when no poster frame is configured")
+				return &events.VideoClip{ID: id}, nil
+			},
+		},
+	}
+
+	s := appEventVideoClipResourceSchema()
+	planVal := appEventVideoClipVal(s, filePath, tftypes.UnknownValue)
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+
+	var data AppEventVideoClipResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.PreviewFrameTimeCode.ValueString() != "00:00:00:00" {
+		t.Errorf("expected PreviewFrameTimeCode '00:00:00:00', got %q", data.PreviewFrameTimeCode.ValueString())
+	}
+}
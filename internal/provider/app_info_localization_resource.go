@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/appinfo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AppInfoLocalizationResource{}
+var _ resource.ResourceWithImportState = &AppInfoLocalizationResource{}
+
+type appInfoLocalizationClient interface {
+	GetEditableAppInfo(ctx context.Context, appID string) (*appinfo.AppInfo, error)
+	ListAppInfoLocalizations(ctx context.Context, appInfoID string) ([]appinfo.Localization, error)
+	CreateAppInfoLocalization(ctx context.Context, appInfoID string, localization appinfo.Localization) (*appinfo.Localization, error)
+	ModifyAppInfoLocalization(ctx context.Context, id string, localization appinfo.Localization) (*appinfo.Localization, error)
+	DeleteAppInfoLocalization(ctx context.Context, id string) error
+}
+
+func NewAppInfoLocalizationResource() resource.Resource {
+	return &AppInfoLocalizationResource{}
+}
+
+// AppInfoLocalizationResource defines the resource implementation.
+type AppInfoLocalizationResource struct {
+	client appInfoLocalizationClient
+}
+
+// AppInfoLocalizationResourceModel describes the resource data model.
+type AppInfoLocalizationResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	AppID             types.String `tfsdk:"app_id"`
+	AppInfoID         types.String `tfsdk:"app_info_id"`
+	Locale            types.String `tfsdk:"locale"`
+	Name              types.String `tfsdk:"name"`
+	Subtitle          types.String `tfsdk:"subtitle"`
+	PrivacyPolicyURL  types.String `tfsdk:"privacy_policy_url"`
+	PrivacyChoicesURL types.String `tfsdk:"privacy_choices_url"`
+}
+
+func (r *AppInfoLocalizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_info_localization"
+}
+
+func (r *AppInfoLocalizationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the localized name, subtitle and privacy URLs of an app in App Store Connect. " +
+			"The primary locale can't be removed, so destroying it only removes it from Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the app info localization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the app the localization belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"app_info_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the editable app info the localization is attached to. Apple creates a new app info for each version, so this may change over time.",
+			},
+			"locale": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The locale of the localization (e.g. `en-GB`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the app as it appears on the App Store in this locale.",
+			},
+			"subtitle": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The subtitle of the app as it appears on the App Store in this locale.",
+			},
+			"privacy_policy_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The URL of the app's privacy policy in this locale.",
+			},
+			"privacy_choices_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The URL where users can learn about and manage their privacy choices in this locale.",
+			},
+		},
+	}
+}
+
+func (r *AppInfoLocalizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(appInfoLocalizationClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected appInfoLocalizationClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AppInfoLocalizationResource) populateState(data *AppInfoLocalizationResourceModel, localization *appinfo.Localization) {
+	data.ID = types.StringValue(localization.ID)
+	data.AppInfoID = types.StringValue(localization.AppInfoID)
+	data.Locale = types.StringValue(localization.Locale)
+	data.Name = types.StringValue(localization.Name)
+	data.Subtitle = optionalString(localization.Subtitle)
+	data.PrivacyPolicyURL = optionalString(localization.PrivacyPolicyURL)
+	data.PrivacyChoicesURL = optionalString(localization.PrivacyChoicesURL)
+}
+
+func (r *AppInfoLocalizationResource) localization(data *AppInfoLocalizationResourceModel) appinfo.Localization {
+	return appinfo.Localization{
+		Locale:            data.Locale.ValueString(),
+		Name:              data.Name.ValueString(),
+		Subtitle:          data.Subtitle.ValueString(),
+		PrivacyPolicyURL:  data.PrivacyPolicyURL.ValueString(),
+		PrivacyChoicesURL: data.PrivacyChoicesURL.ValueString(),
+	}
+}
+
+// findLocalization returns the localization for the given locale on the app's
+// editable app info, or nil if the locale has not been added yet.
+func (r *AppInfoLocalizationResource) findLocalization(ctx context.Context, appID string, locale string) (*appinfo.AppInfo, *appinfo.Localization, error) {
+	info, err := r.client.GetEditableAppInfo(ctx, appID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	localizations, err := r.client.ListAppInfoLocalizations(ctx, info.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, localization := range localizations {
+		if localization.Locale == locale {
+			return info, &localization, nil
+		}
+	}
+
+	return info, nil, nil
+}
+
+func (r *AppInfoLocalizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AppInfoLocalizationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, existing, err := r.findLocalization(ctx, data.AppID.ValueString(), data.Locale.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app info localizations, got error: %s", err))
+		return
+	}
+
+	// The primary locale always exists, so adopt it rather than failing to create a duplicate.
+	var localization *appinfo.Localization
+	if existing != nil {
+		localization, err = r.client.ModifyAppInfoLocalization(ctx, existing.ID, r.localization(&data))
+	} else {
+		localization, err = r.client.CreateAppInfoLocalization(ctx, info.ID, r.localization(&data))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create app info localization, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an app info localization")
+
+	r.populateState(&data, localization)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppInfoLocalizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppInfoLocalizationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, localization, err := r.findLocalization(ctx, data.AppID.ValueString(), data.Locale.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app info localization, got error: %s", err))
+		return
+	}
+	if localization == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.populateState(&data, localization)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppInfoLocalizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AppInfoLocalizationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The identifier changes whenever Apple creates a new app info, so look it up again.
+	_, existing, err := r.findLocalization(ctx, data.AppID.ValueString(), data.Locale.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app info localization, got error: %s", err))
+		return
+	}
+	if existing == nil {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("No %q localization found for app %q", data.Locale.ValueString(), data.AppID.ValueString()))
+		return
+	}
+
+	localization, err := r.client.ModifyAppInfoLocalization(ctx, existing.ID, r.localization(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify app info localization, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified an app info localization")
+
+	r.populateState(&data, localization)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppInfoLocalizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AppInfoLocalizationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, existing, err := r.findLocalization(ctx, data.AppID.ValueString(), data.Locale.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app info localization, got error: %s", err))
+		return
+	}
+	if existing == nil {
+		return
+	}
+
+	// Apple doesn't allow the primary locale to be removed from an app.
+	if info.PrimaryLocale == existing.Locale {
+		resp.Diagnostics.AddWarning(
+			"App info localization not deleted",
+			fmt.Sprintf("%q is the app's primary locale and can't be removed. It has been removed from Terraform state only.", existing.Locale),
+		)
+		return
+	}
+
+	err = r.client.DeleteAppInfoLocalization(ctx, existing.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete app info localization, got error: %s", err))
+		return
+	}
+}
+
+func (r *AppInfoLocalizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	appID, locale, ok := strings.Cut(req.ID, "/")
+	if !ok || appID == "" || locale == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("%q is not a valid import ID. Provide the app ID and locale separated by a slash, e.g. `1234567890/en-GB`.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_id"), appID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("locale"), locale)...)
+}
+
+// optionalString maps an empty API value to null so that optional attributes
+// left out of the configuration do not produce a diff.
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
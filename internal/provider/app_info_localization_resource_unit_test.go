@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/appinfo"
+)
+
+type mockAppInfoLocalizationClient struct {
+	localizations []appinfo.Localization
+	createFn      func(ctx context.Context, appInfoID string, localization appinfo.Localization) (*appinfo.Localization, error)
+	modifyFn      func(ctx context.Context, id string, localization appinfo.Localization) (*appinfo.Localization, error)
+	deletedIDs    []string
+	primaryLocale string
+}
+
+func (m *mockAppInfoLocalizationClient) GetEditableAppInfo(ctx context.Context, appID string) (*appinfo.AppInfo, error) {
+	return &appinfo.AppInfo{ID: "app-info-id", AppID: appID, PrimaryLocale: m.primaryLocale}, nil
+}
+
+func (m *mockAppInfoLocalizationClient) ListAppInfoLocalizations(ctx context.Context, appInfoID string) ([]appinfo.Localization, error) {
+	return m.localizations, nil
+}
+
+func (m *mockAppInfoLocalizationClient) CreateAppInfoLocalization(ctx context.Context, appInfoID string, localization appinfo.Localization) (*appinfo.Localization, error) {
+	if m.createFn != nil {
+		return m.createFn(ctx, appInfoID, localization)
+	}
+	return &appinfo.Localization{}, nil
+}
+
+func (m *mockAppInfoLocalizationClient) ModifyAppInfoLocalization(ctx context.Context, id string, localization appinfo.Localization) (*appinfo.Localization, error) {
+	if m.modifyFn != nil {
+		return m.modifyFn(ctx, id, localization)
+	}
+	return &appinfo.Localization{}, nil
+}
+
+func (m *mockAppInfoLocalizationClient) DeleteAppInfoLocalization(ctx context.Context, id string) error {
+	m.deletedIDs = append(m.deletedIDs, id)
+	return nil
+}
+
+func appInfoLocalizationResourceSchema() schema.Schema {
+	r := &AppInfoLocalizationResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func appInfoLocalizationVal(s schema.Schema, id string, subtitle interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                  tftypes.NewValue(tftypes.String, id),
+		"app_id":              tftypes.NewValue(tftypes.String, "1234567890"),
+		"app_info_id":         tftypes.NewValue(tftypes.String, nil),
+		"locale":              tftypes.NewValue(tftypes.String, "en-GB"),
+		"name":                tftypes.NewValue(tftypes.String, "Example"),
+		"subtitle":            tftypes.NewValue(tftypes.String, subtitle),
+		"privacy_policy_url":  tftypes.NewValue(tftypes.String, "https://example.com/privacy"),
+		"privacy_choices_url": tftypes.NewValue(tftypes.String, nil),
+	})
+}
+
+func TestAppInfoLocalizationResource_Create_CreatesMissingLocale(t *testing.T) {
+	var capturedAppInfoID string
+
+	r := &AppInfoLocalizationResource{
+		client: &mockAppInfoLocalizationClient{
+			createFn: func(ctx context.Context, appInfoID string, localization appinfo.Localization) (*appinfo.Localization, error) {
+				capturedAppInfoID = appInfoID
+				localization.ID = "localization-id"
+				localization.AppInfoID = appInfoID
+				return &localization, nil
+			},
+			modifyFn: func(ctx context.Context, id string, localization appinfo.Localization) (*appinfo.Localization, error) {
+				t.Errorf("expected CreateAppInfoLocalization, but ModifyAppInfoLocalization was called")
+				return &localization, nil
+			},
+		},
+	}
+
+	s := appInfoLocalizationResourceSchema()
+	planVal := appInfoLocalizationVal(s, "", nil)
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if capturedAppInfoID != "app-info-id" {
+		t.Errorf("expected localization to be created on 'app-info-id', got %q", capturedAppInfoID)
+	}
+
+	var data AppInfoLocalizationResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "localization-id" {
+		t.Errorf("expected ID 'localization-id', got %q", data.ID.ValueString())
+	}
+	if data.AppInfoID.ValueString() != "app-info-id" {
+		t.Errorf("expected AppInfoID 'app-info-id', got %q", data.AppInfoID.ValueString())
+	}
+	if !data.Subtitle.IsNull() {
+		t.Errorf("expected Subtitle to be null when not returned by the API, got %q", data.Subtitle.ValueString())
+	}
+	if !data.PrivacyChoicesURL.IsNull() {
+		t.Errorf("expected PrivacyChoicesURL to be null when not returned by the API, got %q", data.PrivacyChoicesURL.ValueString())
+	}
+}
+
+func TestAppInfoLocalizationResource_Create_AdoptsExistingLocale(t *testing.T) {
+	var capturedID string
+
+	r := &AppInfoLocalizationResource{
+		client: &mockAppInfoLocalizationClient{
+			localizations: []appinfo.Localization{
+				{ID: "primary-id", AppInfoID: "app-info-id", Locale: "en-GB", Name: "Old Name"},
+			},
+			createFn: func(ctx context.Context, appInfoID string, localization appinfo.Localization) (*appinfo.Localization, error) {
+				t.Errorf("expected ModifyAppInfoLocalization, but CreateAppInfoLocalization was called")
+				return &localization, nil
+			},
+			modifyFn: func(ctx context.Context, id string, localization appinfo.Localization) (*appinfo.Localization, error) {
+				capturedID = id
+				localization.ID = id
+				localization.AppInfoID = "app-info-id"
+				return &localization, nil
+			},
+		},
+	}
+
+	s := appInfoLocalizationResourceSchema()
+	planVal := appInfoLocalizationVal(s, "", "The best example app")
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if capturedID != "primary-id" {
+		t.Errorf("expected ModifyAppInfoLocalization called with 'primary-id', got %q", capturedID)
+	}
+
+	var data AppInfoLocalizationResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.Name.ValueString() != "Example" {
+		t.Errorf("expected Name 'Example', got %q", data.Name.ValueString())
+	}
+	if data.Subtitle.ValueString() != "The best example app" {
+		t.Errorf("expected Subtitle 'The best example app', got %q", data.Subtitle.ValueString())
+	}
+}
+
+func TestAppInfoLocalizationResource_Read_RemovesMissingLocale(t *testing.T) {
+	r := &AppInfoLocalizationResource{
+		client: &mockAppInfoLocalizationClient{
+			localizations: []appinfo.Localization{
+				{ID: "fr-id", AppInfoID: "app-info-id", Locale: "fr-FR", Name: "Exemple"},
+			},
+		},
+	}
+
+	s := appInfoLocalizationResourceSchema()
+	stateVal := appInfoLocalizationVal(s, "localization-id", nil)
+
+	req := resource.ReadRequest{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+	resp := &resource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("expected resource to be removed from state when the locale no longer exists")
+	}
+}
+
+func TestAppInfoLocalizationResource_Delete(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		primaryLocale string
+		expectDeleted bool
+	}{
+		{name: "additional locale", primaryLocale: "en-US", expectDeleted: true},
+		{name: "primary locale", primaryLocale: "en-GB"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &mockAppInfoLocalizationClient{
+				localizations: []appinfo.Localization{{ID: "localization-id", AppInfoID: "app-info-id", Locale: "en-GB"}},
+				primaryLocale: tc.primaryLocale,
+			}
+			r := &AppInfoLocalizationResource{client: client}
+
+			s := appInfoLocalizationResourceSchema()
+			req := resource.DeleteRequest{
+				State: tfsdk.State{Schema: s, Raw: appInfoLocalizationVal(s, "localization-id", nil)},
+			}
+			resp := &resource.DeleteResponse{}
+
+			r.Delete(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+			}
+			if deleted := len(client.deletedIDs) == 1; deleted != tc.expectDeleted {
+				t.Errorf("expected deleted=%t, got DeleteAppInfoLocalization calls %v", tc.expectDeleted, client.deletedIDs)
+			}
+			if warned := resp.Diagnostics.WarningsCount() == 1; warned == tc.expectDeleted {
+				t.Errorf("expected a warning only when the localization is kept, got %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestAppInfoLocalizationResource_ImportState_RejectsInvalidID(t *testing.T) {
+	r := &AppInfoLocalizationResource{client: &mockAppInfoLocalizationClient{}}
+
+	s := appInfoLocalizationResourceSchema()
+	req := resource.ImportStateRequest{ID: "1234567890"}
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)},
+	}
+
+	r.ImportState(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for an import ID without a locale")
+	}
+}
@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/appinfo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AppInfoResource{}
+var _ resource.ResourceWithImportState = &AppInfoResource{}
+var _ resource.ResourceWithValidateConfig = &AppInfoResource{}
+var _ resource.ResourceWithModifyPlan = &AppInfoResource{}
+
+type appInfoClient interface {
+	GetEditableAppInfo(ctx context.Context, appID string) (*appinfo.AppInfo, error)
+	ModifyAppInfoCategories(ctx context.Context, id string, categories appinfo.Categories) (*appinfo.AppInfo, error)
+	ListAppCategories(ctx context.Context) ([]appinfo.Category, error)
+}
+
+func NewAppInfoResource() resource.Resource {
+	return &AppInfoResource{}
+}
+
+// AppInfoResource defines the resource implementation.
+type AppInfoResource struct {
+	client appInfoClient
+}
+
+// AppInfoResourceModel describes the resource data model.
+type AppInfoResourceModel struct {
+	ID                        types.String `tfsdk:"id"`
+	AppID                     types.String `tfsdk:"app_id"`
+	AppInfoID                 types.String `tfsdk:"app_info_id"`
+	PrimaryCategoryID         types.String `tfsdk:"primary_category_id"`
+	PrimarySubcategoryOneID   types.String `tfsdk:"primary_subcategory_one_id"`
+	PrimarySubcategoryTwoID   types.String `tfsdk:"primary_subcategory_two_id"`
+	SecondaryCategoryID       types.String `tfsdk:"secondary_category_id"`
+	SecondarySubcategoryOneID types.String `tfsdk:"secondary_subcategory_one_id"`
+	SecondarySubcategoryTwoID types.String `tfsdk:"secondary_subcategory_two_id"`
+}
+
+// categorySlot is a category attribute together with the subcategory
+// attributes that must belong to it.
+type categorySlot struct {
+	category      string
+	categoryID    types.String
+	subcategories []subcategorySlot
+}
+
+type subcategorySlot struct {
+	attribute string
+	id        types.String
+}
+
+func (data *AppInfoResourceModel) categorySlots() []categorySlot {
+	return []categorySlot{
+		{
+			category:   "primary_category_id",
+			categoryID: data.PrimaryCategoryID,
+			subcategories: []subcategorySlot{
+				{attribute: "primary_subcategory_one_id", id: data.PrimarySubcategoryOneID},
+				{attribute: "primary_subcategory_two_id", id: data.PrimarySubcategoryTwoID},
+			},
+		},
+		{
+			category:   "secondary_category_id",
+			categoryID: data.SecondaryCategoryID,
+			subcategories: []subcategorySlot{
+				{attribute: "secondary_subcategory_one_id", id: data.SecondarySubcategoryOneID},
+				{attribute: "secondary_subcategory_two_id", id: data.SecondarySubcategoryTwoID},
+			},
+		},
+	}
+}
+
+func (r *AppInfoResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_info"
+}
+
+func (r *AppInfoResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the App Store categories of an app. Categories are checked against Apple's list when " +
+			"planning; use the `appstoreconnect_app_categories` data source to see the options. App info can't be deleted, " +
+			"so destroying this resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the app.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the app whose categories are managed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"app_info_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the editable app info the categories are set on. Apple creates a new app info for each version, so this may change over time.",
+			},
+			"primary_category_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the app's primary category, e.g. `GAMES`.",
+			},
+			"primary_subcategory_one_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The identifier of the first subcategory of the primary category, e.g. `GAMES_PUZZLE`.",
+			},
+			"primary_subcategory_two_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The identifier of the second subcategory of the primary category.",
+			},
+			"secondary_category_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The identifier of the app's secondary category.",
+			},
+			"secondary_subcategory_one_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The identifier of the first subcategory of the secondary category.",
+			},
+			"secondary_subcategory_two_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The identifier of the second subcategory of the secondary category.",
+			},
+		},
+	}
+}
+
+func (r *AppInfoResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(appInfoClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected appInfoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r AppInfoResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AppInfoResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.SecondaryCategoryID.IsNull() && !data.SecondaryCategoryID.IsUnknown() && data.SecondaryCategoryID.Equal(data.PrimaryCategoryID) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("secondary_category_id"),
+			"Invalid Configuration",
+			"`secondary_category_id` must be different from `primary_category_id`.",
+		)
+	}
+
+	for _, slot := range data.categorySlots() {
+		one, two := slot.subcategories[0], slot.subcategories[1]
+
+		for _, subcategory := range slot.subcategories {
+			if !subcategory.id.IsNull() && slot.categoryID.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(subcategory.attribute),
+					"Invalid Configuration",
+					fmt.Sprintf("`%s` requires `%s` to be set.", subcategory.attribute, slot.category),
+				)
+			}
+		}
+
+		if !two.id.IsNull() && one.id.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(two.attribute),
+				"Invalid Configuration",
+				fmt.Sprintf("`%s` requires `%s` to be set.", two.attribute, one.attribute),
+			)
+		}
+		if !two.id.IsNull() && !two.id.IsUnknown() && two.id.Equal(one.id) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(two.attribute),
+				"Invalid Configuration",
+				fmt.Sprintf("`%s` must be different from `%s`.", two.attribute, one.attribute),
+			)
+		}
+	}
+}
+
+// ModifyPlan checks every category and subcategory against Apple's list, so
+// that a misspelt or misplaced category is caught before apply.
+func (r *AppInfoResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed or the provider
+	// hasn't been configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data AppInfoResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Categories already in state were checked when they were planned.
+	if !req.State.Raw.IsNull() {
+		var state AppInfoResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if r.categories(&data) == r.categories(&state) {
+			return
+		}
+	}
+
+	categories, err := r.client.ListAppCategories(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list app categories, got error: %s", err))
+		return
+	}
+
+	for _, slot := range data.categorySlots() {
+		if slot.categoryID.IsNull() || slot.categoryID.IsUnknown() {
+			continue
+		}
+
+		index := slices.IndexFunc(categories, func(category appinfo.Category) bool {
+			return category.ID == slot.categoryID.ValueString()
+		})
+		if index == -1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(slot.category),
+				"Invalid Configuration",
+				fmt.Sprintf("%q is not an App Store category.", slot.categoryID.ValueString()),
+			)
+			continue
+		}
+
+		for _, subcategory := range slot.subcategories {
+			if subcategory.id.IsNull() || subcategory.id.IsUnknown() {
+				continue
+			}
+
+			found := slices.ContainsFunc(categories[index].Subcategories, func(candidate appinfo.Category) bool {
+				return candidate.ID == subcategory.id.ValueString()
+			})
+			if !found {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(subcategory.attribute),
+					"Invalid Configuration",
+					fmt.Sprintf("%q is not a subcategory of %q.", subcategory.id.ValueString(), slot.categoryID.ValueString()),
+				)
+			}
+		}
+	}
+}
+
+func (r *AppInfoResource) populateState(data *AppInfoResourceModel, info *appinfo.AppInfo) {
+	data.ID = types.StringValue(info.AppID)
+	data.AppID = types.StringValue(info.AppID)
+	data.AppInfoID = types.StringValue(info.ID)
+	data.PrimaryCategoryID = optionalString(info.Categories.Primary)
+	data.PrimarySubcategoryOneID = optionalString(info.Categories.PrimarySubcategoryOne)
+	data.PrimarySubcategoryTwoID = optionalString(info.Categories.PrimarySubcategoryTwo)
+	data.SecondaryCategoryID = optionalString(info.Categories.Secondary)
+	data.SecondarySubcategoryOneID = optionalString(info.Categories.SecondarySubcategoryOne)
+	data.SecondarySubcategoryTwoID = optionalString(info.Categories.SecondarySubcategoryTwo)
+}
+
+func (r *AppInfoResource) categories(data *AppInfoResourceModel) appinfo.Categories {
+	return appinfo.Categories{
+		Primary:                 data.PrimaryCategoryID.ValueString(),
+		PrimarySubcategoryOne:   data.PrimarySubcategoryOneID.ValueString(),
+		PrimarySubcategoryTwo:   data.PrimarySubcategoryTwoID.ValueString(),
+		Secondary:               data.SecondaryCategoryID.ValueString(),
+		SecondarySubcategoryOne: data.SecondarySubcategoryOneID.ValueString(),
+		SecondarySubcategoryTwo: data.SecondarySubcategoryTwoID.ValueString(),
+	}
+}
+
+// setCategories sets the categories in data on the app's editable app info,
+// then records the result in data.
+func (r *AppInfoResource) setCategories(ctx context.Context, data *AppInfoResourceModel) error {
+	// The identifier changes whenever Apple creates a new app info, so look it up again.
+	info, err := r.client.GetEditableAppInfo(ctx, data.AppID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	updated, err := r.client.ModifyAppInfoCategories(ctx, info.ID, r.categories(data))
+	if err != nil {
+		return err
+	}
+
+	r.populateState(data, updated)
+	return nil
+}
+
+func (r *AppInfoResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AppInfoResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setCategories(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set app categories, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "set app categories")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppInfoResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppInfoResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, err := r.client.GetEditableAppInfo(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app info, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, info)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppInfoResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AppInfoResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setCategories(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update app categories, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated app categories")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppInfoResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// App info cannot be deleted, so it is only removed from state.
+	tflog.Trace(ctx, "removed app info from state")
+}
+
+func (r *AppInfoResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
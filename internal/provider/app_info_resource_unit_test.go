@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/appinfo"
+)
+
+type mockAppInfoClient struct {
+	modifiedID       string
+	modified         appinfo.Categories
+	listedCategories int
+}
+
+func (m *mockAppInfoClient) GetEditableAppInfo(ctx context.Context, appID string) (*appinfo.AppInfo, error) {
+	return &appinfo.AppInfo{ID: "app-info-id", AppID: appID}, nil
+}
+
+func (m *mockAppInfoClient) ModifyAppInfoCategories(ctx context.Context, id string, categories appinfo.Categories) (*appinfo.AppInfo, error) {
+	m.modifiedID = id
+	m.modified = categories
+	return &appinfo.AppInfo{ID: id, AppID: "app-id", Categories: categories}, nil
+}
+
+func (m *mockAppInfoClient) ListAppCategories(ctx context.Context) ([]appinfo.Category, error) {
+	m.listedCategories++
+	return []appinfo.Category{
+		{ID: "GAMES", Subcategories: []appinfo.Category{{ID: "GAMES_PUZZLE"}, {ID: "GAMES_WORD"}}},
+		{ID: "EDUCATION"},
+	}, nil
+}
+
+func appInfoResourceSchema() schema.Schema {
+	r := &AppInfoResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func appInfoVal(s schema.Schema, primary interface{}, primaryOne interface{}, primaryTwo interface{}, secondary interface{}, secondaryOne interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                           tftypes.NewValue(tftypes.String, nil),
+		"app_id":                       tftypes.NewValue(tftypes.String, "app-id"),
+		"app_info_id":                  tftypes.NewValue(tftypes.String, nil),
+		"primary_category_id":          tftypes.NewValue(tftypes.String, primary),
+		"primary_subcategory_one_id":   tftypes.NewValue(tftypes.String, primaryOne),
+		"primary_subcategory_two_id":   tftypes.NewValue(tftypes.String, primaryTwo),
+		"secondary_category_id":        tftypes.NewValue(tftypes.String, secondary),
+		"secondary_subcategory_one_id": tftypes.NewValue(tftypes.String, secondaryOne),
+		"secondary_subcategory_two_id": tftypes.NewValue(tftypes.String, nil),
+	})
+}
+
+func TestAppInfoResource_Create_SetsCategoriesOnEditableAppInfo(t *testing.T) {
+	client := &mockAppInfoClient{}
+	r := &AppInfoResource{client: client}
+
+	s := appInfoResourceSchema()
+	planVal := appInfoVal(s, "GAMES", "GAMES_PUZZLE", nil, "EDUCATION", nil)
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if client.modifiedID != "app-info-id" {
+		t.Errorf("expected the editable app info to be modified, got %q", client.modifiedID)
+	}
+	if client.modified.Primary != "GAMES" || client.modified.PrimarySubcategoryOne != "GAMES_PUZZLE" || client.modified.Secondary != "EDUCATION" {
+		t.Errorf("unexpected categories sent: %+v", client.modified)
+	}
+
+	var data AppInfoResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "app-id" || data.AppInfoID.ValueString() != "app-info-id" {
+		t.Errorf("expected ID 'app-id' and app info ID 'app-info-id', got %q and %q", data.ID.ValueString(), data.AppInfoID.ValueString())
+	}
+	if !data.PrimarySubcategoryTwoID.IsNull() {
+		t.Errorf("expected unset subcategory to be null, got %q", data.PrimarySubcategoryTwoID.ValueString())
+	}
+}
+
+func TestAppInfoResource_ModifyPlan(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		primary     string
+		primaryOne  interface{}
+		secondary   interface{}
+		expectError bool
+	}{
+		{name: "valid categories", primary: "GAMES", primaryOne: "GAMES_PUZZLE", secondary: "EDUCATION"},
+		{name: "unknown category", primary: "GAMING", expectError: true},
+		{name: "unknown secondary category", primary: "GAMES", secondary: "SCHOOL", expectError: true},
+		{name: "subcategory of another category", primary: "EDUCATION", primaryOne: "GAMES_PUZZLE", expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &AppInfoResource{client: &mockAppInfoClient{}}
+
+			s := appInfoResourceSchema()
+			planVal := appInfoVal(s, tc.primary, tc.primaryOne, nil, tc.secondary, nil)
+
+			req := resource.ModifyPlanRequest{
+				Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+			}
+			resp := &resource.ModifyPlanResponse{
+				Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+			}
+
+			r.ModifyPlan(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error=%t, got diagnostics %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestAppInfoResource_ModifyPlan_SkipsUnchangedCategories(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		primary    string
+		wantListed int
+	}{
+		{name: "unchanged", primary: "GAMES"},
+		{name: "changed", primary: "EDUCATION", wantListed: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &mockAppInfoClient{}
+			r := &AppInfoResource{client: client}
+
+			s := appInfoResourceSchema()
+			planVal := appInfoVal(s, tc.primary, nil, nil, "EDUCATION", nil)
+
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: s, Raw: appInfoVal(s, "GAMES", nil, nil, "EDUCATION", nil)},
+				Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+			}
+			resp := &resource.ModifyPlanResponse{
+				Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+			}
+
+			r.ModifyPlan(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+			}
+			if client.listedCategories != tc.wantListed {
+				t.Errorf("expected categories to be listed %d times, got %d", tc.wantListed, client.listedCategories)
+			}
+		})
+	}
+}
+
+func TestAppInfoResource_ValidateConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		primary      string
+		primaryOne   interface{}
+		primaryTwo   interface{}
+		secondary    interface{}
+		secondaryOne interface{}
+		expectError  bool
+	}{
+		{name: "primary only", primary: "GAMES"},
+		{name: "both subcategories", primary: "GAMES", primaryOne: "GAMES_PUZZLE", primaryTwo: "GAMES_WORD"},
+		{name: "secondary with subcategory", primary: "EDUCATION", secondary: "GAMES", secondaryOne: "GAMES_PUZZLE"},
+		{name: "same category twice", primary: "GAMES", secondary: "GAMES", expectError: true},
+		{name: "second subcategory without first", primary: "GAMES", primaryTwo: "GAMES_WORD", expectError: true},
+		{name: "same subcategory twice", primary: "GAMES", primaryOne: "GAMES_WORD", primaryTwo: "GAMES_WORD", expectError: true},
+		{name: "secondary subcategory without category", primary: "GAMES", secondaryOne: "GAMES_PUZZLE", expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := AppInfoResource{}
+
+			s := appInfoResourceSchema()
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: appInfoVal(s, tc.primary, tc.primaryOne, tc.primaryTwo, tc.secondary, tc.secondaryOne)},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error=%t, got diagnostics %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/previews"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AppPreviewResource{}
+var _ resource.ResourceWithValidateConfig = &AppPreviewResource{}
+var _ resource.ResourceWithModifyPlan = &AppPreviewResource{}
+
+type appPreviewClient interface {
+	ReserveAppPreview(ctx context.Context, setID string, fileName string, fileSize int64) (*previews.Preview, error)
+	CommitAppPreview(ctx context.Context, id string, checksum string) (*previews.Preview, error)
+	ModifyAppPreview(ctx context.Context, id string, preview previews.Preview) (*previews.Preview, error)
+	GetAppPreview(ctx context.Context, id string) (*previews.Preview, error)
+	DeleteAppPreview(ctx context.Context, id string) error
+	ListAppPreviewIDs(ctx context.Context, setID string) ([]string, error)
+	ReorderAppPreviews(ctx context.Context, setID string, ids []string) error
+}
+
+// appPreviewOrder is shared by every app preview resource so that reordering a
+// set is serialised across the resources Terraform creates in parallel.
+var appPreviewOrder = newAssetOrder()
+
+func NewAppPreviewResource() resource.Resource {
+	return &AppPreviewResource{}
+}
+
+// AppPreviewResource defines the resource implementation.
+type AppPreviewResource struct {
+	client appPreviewClient
+}
+
+// AppPreviewResourceModel describes the resource data model.
+type AppPreviewResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	AppPreviewSetID      types.String `tfsdk:"app_preview_set_id"`
+	FilePath             types.String `tfsdk:"file_path"`
+	Position             types.Int64  `tfsdk:"position"`
+	PreviewFrameTimeCode types.String `tfsdk:"preview_frame_time_code"`
+	FileName             types.String `tfsdk:"file_name"`
+	SourceFileChecksum   types.String `tfsdk:"source_file_checksum"`
+	AssetDeliveryState   types.String `tfsdk:"asset_delivery_state"`
+}
+
+func (r *AppPreviewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_preview"
+}
+
+func (r *AppPreviewResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uploads an app preview from a local file to an App Store app preview set. The app preview is replaced whenever the MD5 checksum of the local file differs from the checksum stored by Apple.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the app preview.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_preview_set_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the app preview set to upload the app preview to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The path to the video file to upload.",
+			},
+			"position": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The 1-based position of the app preview within its set. When omitted, the app preview is left where Apple places it.",
+			},
+			"preview_frame_time_code": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The time code of the frame used as the poster image (e.g. `00:00:05:00`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"file_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The file name Apple recorded for the upload.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_file_checksum": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The MD5 checksum of the uploaded file, as stored by Apple.",
+			},
+			"asset_delivery_state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The processing state of the uploaded asset (e.g. `UPLOAD_COMPLETE`, `COMPLETE`, `FAILED`).",
+			},
+		},
+	}
+}
+
+func (r *AppPreviewResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(appPreviewClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected appPreviewClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r AppPreviewResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AppPreviewResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Position.IsNull() && !data.Position.IsUnknown() && data.Position.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("position"),
+			"Invalid Configuration",
+			"`position` is 1-based and must be at least 1.",
+		)
+	}
+}
+
+func (r AppPreviewResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan AppPreviewResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.FilePath.IsUnknown() {
+		return
+	}
+
+	_, checksum, err := readAsset(plan.FilePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file_path"),
+			"Unable to read app preview",
+			fmt.Sprintf("Unable to read %q, got error: %s", plan.FilePath.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_file_checksum"), checksum)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state AppPreviewResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	// Uploaded assets cannot be modified, so a different file means a new app preview.
+	if state.SourceFileChecksum.ValueString() != checksum {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("source_file_checksum"))
+	}
+}
+
+func (r *AppPreviewResource) populateState(data *AppPreviewResourceModel, preview *previews.Preview) {
+	data.ID = types.StringValue(preview.ID)
+	data.FileName = types.StringValue(preview.FileName)
+	data.SourceFileChecksum = types.StringValue(preview.SourceFileChecksum)
+	data.AssetDeliveryState = types.StringValue(preview.AssetDeliveryState)
+	data.PreviewFrameTimeCode = types.StringValue(preview.PreviewFrameTimeCode)
+}
+
+func (r *AppPreviewResource) reorder(ctx context.Context, data *AppPreviewResourceModel) error {
+	if data.Position.IsNull() {
+		return nil
+	}
+
+	return appPreviewOrder.reorder(
+		ctx,
+		data.AppPreviewSetID.ValueString(),
+		data.ID.ValueString(),
+		data.Position.ValueInt64(),
+		r.client.ListAppPreviewIDs,
+		r.client.ReorderAppPreviews,
+	)
+}
+
+func (r *AppPreviewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AppPreviewResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	file, checksum, err := readAsset(data.FilePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("File Error", fmt.Sprintf("Unable to read app preview, got error: %s", err))
+		return
+	}
+
+	reservation, err := r.client.ReserveAppPreview(ctx, data.AppPreviewSetID.ValueString(), filepath.Base(data.FilePath.ValueString()), int64(len(file)))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reserve app preview, got error: %s", err))
+		return
+	}
+
+	err = uploadAsset(ctx, reservation.UploadOperations, file)
+	if err != nil {
+		resp.Diagnostics.AddError("Upload Error", fmt.Sprintf("Unable to upload app preview, got error: %s", err))
+		return
+	}
+
+	preview, err := r.client.CommitAppPreview(ctx, reservation.ID, checksum)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to commit app preview, got error: %s", err))
+		return
+	}
+
+	if !data.PreviewFrameTimeCode.IsUnknown() {
+		preview, err = r.client.ModifyAppPreview(ctx, preview.ID, previews.Preview{
+			PreviewFrameTimeCode: data.PreviewFrameTimeCode.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set app preview poster frame, got error: %s", err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "uploaded an app preview")
+
+	r.populateState(&data, preview)
+
+	err = r.reorder(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reorder app previews, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppPreviewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppPreviewResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preview, err := r.client.GetAppPreview(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app preview, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, preview)
+
+	if !data.Position.IsNull() {
+		ids, err := r.client.ListAppPreviewIDs(ctx, data.AppPreviewSetID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app preview order, got error: %s", err))
+			return
+		}
+		data.Position = types.Int64Value(positionOf(ids, data.ID.ValueString()))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppPreviewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AppPreviewResourceModel
+
+	// A changed file forces replacement, so only the position and poster frame can be updated in place.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.reorder(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reorder app previews, got error: %s", err))
+		return
+	}
+
+	preview, err := r.client.ModifyAppPreview(ctx, data.ID.ValueString(), previews.Preview{
+		PreviewFrameTimeCode: data.PreviewFrameTimeCode.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify app preview, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, preview)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppPreviewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AppPreviewResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAppPreview(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete app preview, got error: %s", err))
+		return
+	}
+}
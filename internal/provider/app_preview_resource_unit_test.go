@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/previews"
+)
+
+type mockAppPreviewClient struct {
+	modifyFn func(ctx context.Context, id string, preview previews.Preview) (*previews.Preview, error)
+}
+
+func (m *mockAppPreviewClient) ReserveAppPreview(ctx context.Context, setID string, fileName string, fileSize int64) (*previews.Preview, error) {
+	return &previews.Preview{ID: "preview-id", FileName: fileName}, nil
+}
+
+func (m *mockAppPreviewClient) CommitAppPreview(ctx context.Context, id string, checksum string) (*previews.Preview, error) {
+	return &previews.Preview{ID: id, FileName: "preview.mp4", SourceFileChecksum: checksum, AssetDeliveryState: "UPLOAD_COMPLETE", PreviewFrameTimeCode: "00:00:00:00"}, nil
+}
+
+func (m *mockAppPreviewClient) ModifyAppPreview(ctx context.Context, id string, preview previews.Preview) (*previews.Preview, error) {
+	if m.modifyFn != nil {
+		return m.modifyFn(ctx, id, preview)
+	}
+	return &previews.Preview{ID: id}, nil
+}
+
+func (m *mockAppPreviewClient) GetAppPreview(ctx context.Context, id string) (*previews.Preview, error) {
+	return &previews.Preview{ID: id}, nil
+}
+
+func (m *mockAppPreviewClient) DeleteAppPreview(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *mockAppPreviewClient) ListAppPreviewIDs(ctx context.Context, setID string) ([]string, error) {
+	return []string{"preview-id"}, nil
+}
+
+func (m *mockAppPreviewClient) ReorderAppPreviews(ctx context.Context, setID string, ids []string) error {
+	return nil
+}
+
+func appPreviewResourceSchema() schema.Schema {
+	r := &AppPreviewResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func appPreviewVal(s schema.Schema, filePath string, frameTimeCode interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                      tftypes.NewValue(tftypes.String, nil),
+		"app_preview_set_id":      tftypes.NewValue(tftypes.String, "set-id"),
+		"file_path":               tftypes.NewValue(tftypes.String, filePath),
+		"position":                tftypes.NewValue(tftypes.Number, nil),
+		"preview_frame_time_code": tftypes.NewValue(tftypes.String, frameTimeCode),
+		"file_name":               tftypes.NewValue(tftypes.String, nil),
+		"source_file_checksum":    tftypes.NewValue(tftypes.String, "5eb63bbbe01eeed093cb22bb8f5acdc3"),
+		"asset_delivery_state":    tftypes.NewValue(tftypes.String, nil),
+	})
+}
+
+func TestAppPreviewResource_Create_SetsPosterFrameWhenConfigured(t *testing.T) {
+	filePath := writeScreenshotFile(t, "hello world")
+
+	var capturedTimeCode string
+	r := &AppPreviewResource{
+		client: &mockAppPreviewClient{
+			modifyFn: func(ctx context.Context, id string, preview previews.Preview) (*previews.Preview, error) {
+				capturedTimeCode = preview.PreviewFrameTimeCode
+				return &previews.Preview{ID: id, FileName: "preview.mp4", SourceFileChecksum: "5eb63bbbe01eeed093cb22bb8f5acdc3", PreviewFrameTimeCode: preview.PreviewFrameTimeCode}, nil
+			},
+		},
+	}
+
+	s := appPreviewResourceSchema()
+	planVal := appPreviewVal(s, filePath, "00:00:05:00")
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if capturedTimeCode != "00:00:05:00" {
+		t.Errorf("expected ModifyAppPreview called with '00:00:05:00', got %q", capturedTimeCode)
+	}
+
+	var data AppPreviewResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.PreviewFrameTimeCode.ValueString() != "00:00:05:00" {
+		t.Errorf("expected PreviewFrameTimeCode '00:00:05:00', got %q", data.PreviewFrameTimeCode.ValueString())
+	}
+}
+
+func TestAppPreviewResource_Create_KeepsDefaultPosterFrame(t *testing.T) {
+	filePath := writeScreenshotFile(t, "hello world")
+
+	r := &AppPreviewResource{
+		client: &mockAppPreviewClient{
+			modifyFn: func(ctx context.Context, id string, preview previews.Preview) (*previews.Preview, error) {
+				t.Errorf("expected ModifyAppPreview not to be called when no poster frame is configured")
+				return &previews.Preview{ID: id}, nil
+			},
+		},
+	}
+
+	s := appPreviewResourceSchema()
+	planVal := appPreviewVal(s, filePath, tftypes.UnknownValue)
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+
+	var data AppPreviewResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.PreviewFrameTimeCode.ValueString() != "00:00:00:00" {
+		t.Errorf("expected PreviewFrameTimeCode '00:00:00:00', got %q", data.PreviewFrameTimeCode.ValueString())
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/previews"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AppPreviewSetResource{}
+var _ resource.ResourceWithImportState = &AppPreviewSetResource{}
+var _ resource.ResourceWithValidateConfig = &AppPreviewSetResource{}
+
+type appPreviewSetClient interface {
+	CreateAppPreviewSet(ctx context.Context, set previews.PreviewSet) (*previews.PreviewSet, error)
+	GetAppPreviewSet(ctx context.Context, id string) (*previews.PreviewSet, error)
+	DeleteAppPreviewSet(ctx context.Context, id string) error
+}
+
+func NewAppPreviewSetResource() resource.Resource {
+	return &AppPreviewSetResource{}
+}
+
+// AppPreviewSetResource defines the resource implementation.
+type AppPreviewSetResource struct {
+	client appPreviewSetClient
+}
+
+// AppPreviewSetResourceModel describes the resource data model.
+type AppPreviewSetResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	LocalizationID          types.String `tfsdk:"localization_id"`
+	TreatmentLocalizationID types.String `tfsdk:"experiment_treatment_localization_id"`
+	PreviewType             types.String `tfsdk:"preview_type"`
+}
+
+func (r *AppPreviewSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_preview_set"
+}
+
+func (r *AppPreviewSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a set of App Store app previews for one device size within an App Store version localization " +
+			"or a product page optimization treatment localization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the app preview set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"localization_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The identifier of the App Store version localization the app previews belong to. Exactly one of `localization_id` or `experiment_treatment_localization_id` must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"experiment_treatment_localization_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The identifier of the experiment treatment localization the app previews belong to, from the treatment's `localization_ids`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"preview_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The device size the app previews are for (e.g. `IPHONE_67`, `IPAD_PRO_3GEN_129`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AppPreviewSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(appPreviewSetClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected appPreviewSetClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r AppPreviewSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AppPreviewSetResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.LocalizationID.IsUnknown() || data.TreatmentLocalizationID.IsUnknown() {
+		return
+	}
+
+	if data.LocalizationID.IsNull() == data.TreatmentLocalizationID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("localization_id"),
+			"Invalid Configuration",
+			"Exactly one of `localization_id` or `experiment_treatment_localization_id` must be set.",
+		)
+	}
+}
+
+func (r *AppPreviewSetResource) populateState(data *AppPreviewSetResourceModel, set *previews.PreviewSet) {
+	data.ID = types.StringValue(set.ID)
+	data.LocalizationID = optionalString(set.LocalizationID)
+	data.TreatmentLocalizationID = optionalString(set.TreatmentLocalizationID)
+	data.PreviewType = types.StringValue(set.PreviewType)
+}
+
+func (r *AppPreviewSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AppPreviewSetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	set, err := r.client.CreateAppPreviewSet(ctx, previews.PreviewSet{
+		LocalizationID:          data.LocalizationID.ValueString(),
+		TreatmentLocalizationID: data.TreatmentLocalizationID.ValueString(),
+		PreviewType:             data.PreviewType.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create app preview set, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a app preview set")
+
+	r.populateState(&data, set)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppPreviewSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppPreviewSetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	set, err := r.client.GetAppPreviewSet(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app preview set, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, set)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppPreviewSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AppPreviewSetResourceModel
+
+	// Every configurable attribute forces replacement, so there is nothing to send.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppPreviewSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AppPreviewSetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAppPreviewSet(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete app preview set, got error: %s", err))
+		return
+	}
+}
+
+func (r *AppPreviewSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
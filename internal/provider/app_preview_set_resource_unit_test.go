@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/previews"
+)
+
+type mockAppPreviewSetClient struct {
+	createFn   func(ctx context.Context, set previews.PreviewSet) (*previews.PreviewSet, error)
+	deletedIDs []string
+}
+
+func (m *mockAppPreviewSetClient) CreateAppPreviewSet(ctx context.Context, set previews.PreviewSet) (*previews.PreviewSet, error) {
+	if m.createFn != nil {
+		return m.createFn(ctx, set)
+	}
+	return &previews.PreviewSet{}, nil
+}
+
+func (m *mockAppPreviewSetClient) GetAppPreviewSet(ctx context.Context, id string) (*previews.PreviewSet, error) {
+	return &previews.PreviewSet{ID: id}, nil
+}
+
+func (m *mockAppPreviewSetClient) DeleteAppPreviewSet(ctx context.Context, id string) error {
+	m.deletedIDs = append(m.deletedIDs, id)
+	return nil
+}
+
+func appPreviewSetResourceSchema() schema.Schema {
+	r := &AppPreviewSetResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func appPreviewSetVal(s schema.Schema, id interface{}, localizationID interface{}, treatmentLocalizationID interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                                   tftypes.NewValue(tftypes.String, id),
+		"localization_id":                      tftypes.NewValue(tftypes.String, localizationID),
+		"experiment_treatment_localization_id": tftypes.NewValue(tftypes.String, treatmentLocalizationID),
+		"preview_type":                         tftypes.NewValue(tftypes.String, "IPHONE_67"),
+	})
+}
+
+func TestAppPreviewSetResource_Create_SetsStateCorrectly(t *testing.T) {
+	var captured previews.PreviewSet
+	r := &AppPreviewSetResource{
+		client: &mockAppPreviewSetClient{
+			createFn: func(ctx context.Context, set previews.PreviewSet) (*previews.PreviewSet, error) {
+				captured = set
+				set.ID = "set-id"
+				return &set, nil
+			},
+		},
+	}
+
+	s := appPreviewSetResourceSchema()
+	planVal := appPreviewSetVal(s, nil, "localization-id", nil)
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if captured.LocalizationID != "localization-id" {
+		t.Errorf("expected CreateAppPreviewSet called with 'localization-id', got %q", captured.LocalizationID)
+	}
+
+	var data AppPreviewSetResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "set-id" {
+		t.Errorf("expected ID 'set-id', got %q", data.ID.ValueString())
+	}
+	if data.PreviewType.ValueString() != "IPHONE_67" {
+		t.Errorf("expected PreviewType 'IPHONE_67', got %q", data.PreviewType.ValueString())
+	}
+	if !data.TreatmentLocalizationID.IsNull() {
+		t.Errorf("expected no TreatmentLocalizationID, got %q", data.TreatmentLocalizationID.ValueString())
+	}
+}
+
+func TestAppPreviewSetResource_Create_ForTreatmentLocalization(t *testing.T) {
+	var captured previews.PreviewSet
+	r := &AppPreviewSetResource{
+		client: &mockAppPreviewSetClient{
+			createFn: func(ctx context.Context, set previews.PreviewSet) (*previews.PreviewSet, error) {
+				captured = set
+				set.ID = "set-id"
+				return &set, nil
+			},
+		},
+	}
+
+	s := appPreviewSetResourceSchema()
+	planVal := appPreviewSetVal(s, nil, nil, "treatment-localization-id")
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if captured.TreatmentLocalizationID != "treatment-localization-id" || captured.LocalizationID != "" {
+		t.Errorf("expected CreateAppPreviewSet called for 'treatment-localization-id' only, got %+v", captured)
+	}
+
+	var data AppPreviewSetResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.TreatmentLocalizationID.ValueString() != "treatment-localization-id" {
+		t.Errorf("expected TreatmentLocalizationID 'treatment-localization-id', got %q", data.TreatmentLocalizationID.ValueString())
+	}
+	if !data.LocalizationID.IsNull() {
+		t.Errorf("expected no LocalizationID, got %q", data.LocalizationID.ValueString())
+	}
+}
+
+func TestAppPreviewSetResource_Delete_DeletesSet(t *testing.T) {
+	client := &mockAppPreviewSetClient{}
+	r := &AppPreviewSetResource{client: client}
+
+	s := appPreviewSetResourceSchema()
+	req := resource.DeleteRequest{
+		State: tfsdk.State{Schema: s, Raw: appPreviewSetVal(s, "set-id", "localization-id", nil)},
+	}
+	resp := &resource.DeleteResponse{}
+
+	r.Delete(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.deletedIDs) != 1 || client.deletedIDs[0] != "set-id" {
+		t.Errorf("expected DeleteAppPreviewSet called with 'set-id', got %v", client.deletedIDs)
+	}
+}
+
+func TestAppPreviewSetResource_ValidateConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name                    string
+		localizationID          interface{}
+		treatmentLocalizationID interface{}
+		expectError             bool
+	}{
+		{name: "version localization", localizationID: "localization-id"},
+		{name: "experiment treatment localization", treatmentLocalizationID: "treatment-localization-id"},
+		{name: "none", expectError: true},
+		{name: "both", localizationID: "localization-id", treatmentLocalizationID: "treatment-localization-id", expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := AppPreviewSetResource{}
+
+			s := appPreviewSetResourceSchema()
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: appPreviewSetVal(s, nil, tc.localizationID, tc.treatmentLocalizationID)},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error=%t, got diagnostics %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/pricing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AppPriceScheduleResource{}
+var _ resource.ResourceWithImportState = &AppPriceScheduleResource{}
+var _ resource.ResourceWithValidateConfig = &AppPriceScheduleResource{}
+var _ resource.ResourceWithModifyPlan = &AppPriceScheduleResource{}
+
+type appPriceScheduleClient interface {
+	GetAppPriceSchedule(ctx context.Context, appID string) (*pricing.PriceSchedule, error)
+	SetAppPriceSchedule(ctx context.Context, schedule pricing.PriceSchedule) (*pricing.PriceSchedule, error)
+	ListAppPricePoints(ctx context.Context, appID string, territory string) ([]pricing.PricePoint, error)
+}
+
+func NewAppPriceScheduleResource() resource.Resource {
+	return &AppPriceScheduleResource{}
+}
+
+// AppPriceScheduleResource defines the resource implementation.
+type AppPriceScheduleResource struct {
+	client appPriceScheduleClient
+}
+
+// AppPriceScheduleResourceModel describes the resource data model.
+type AppPriceScheduleResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	AppID         types.String `tfsdk:"app_id"`
+	BaseTerritory types.String `tfsdk:"base_territory"`
+	Prices        types.Set    `tfsdk:"price"`
+}
+
+func (r *AppPriceScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_price_schedule"
+}
+
+func (r *AppPriceScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the prices of a paid app. App Store Connect equalizes the base territory price " +
+			"across every other territory, unless a territory has its own price. Price schedules can't be deleted, " +
+			"so destroying this resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the app.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the app to price.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"base_territory": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The three-letter code of the territory other prices are equalized from, e.g. `USA`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"price": priceBlock("app"),
+		},
+	}
+}
+
+func (r *AppPriceScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(appPriceScheduleClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected appPriceScheduleClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r AppPriceScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AppPriceScheduleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatePrices(ctx, data.BaseTerritory, data.Prices)...)
+}
+
+// ModifyPlan checks that every new price point exists in its territory, so
+// that a price point from the wrong territory or product is caught before
+// apply. Prices already in state were checked when they were planned.
+func (r *AppPriceScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed or the provider
+	// hasn't been configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data AppPriceScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AppID.IsUnknown() || data.Prices.IsUnknown() {
+		return
+	}
+
+	var models []priceModel
+	resp.Diagnostics.Append(data.Prices.ElementsAs(ctx, &models, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing := map[string][]string{}
+	if !req.State.Raw.IsNull() {
+		var state AppPriceScheduleResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if state.AppID.Equal(data.AppID) {
+			var stateModels []priceModel
+			resp.Diagnostics.Append(state.Prices.ElementsAs(ctx, &stateModels, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			for _, model := range stateModels {
+				territory := model.Territory.ValueString()
+				existing[territory] = append(existing[territory], model.PricePointID.ValueString())
+			}
+		}
+	}
+
+	pointIDs := map[string][]string{}
+	for _, model := range models {
+		if model.Territory.IsUnknown() || model.PricePointID.IsUnknown() {
+			continue
+		}
+
+		territory := model.Territory.ValueString()
+		if slices.Contains(existing[territory], model.PricePointID.ValueString()) {
+			continue
+		}
+		if _, ok := pointIDs[territory]; !ok {
+			points, err := r.client.ListAppPricePoints(ctx, data.AppID.ValueString(), territory)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list app price points, got error: %s", err))
+				return
+			}
+
+			pointIDs[territory] = []string{}
+			for _, point := range points {
+				pointIDs[territory] = append(pointIDs[territory], point.ID)
+			}
+		}
+
+		if !slices.Contains(pointIDs[territory], model.PricePointID.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("price"),
+				"Invalid Configuration",
+				fmt.Sprintf("Price point %q does not exist for this app in territory %q.", model.PricePointID.ValueString(), territory),
+			)
+		}
+	}
+}
+
+func (r *AppPriceScheduleResource) populateState(ctx context.Context, data *AppPriceScheduleResourceModel, schedule *pricing.PriceSchedule) diag.Diagnostics {
+	data.ID = types.StringValue(schedule.AppID)
+	data.AppID = types.StringValue(schedule.AppID)
+	data.BaseTerritory = types.StringValue(schedule.BaseTerritory)
+
+	prices := []scheduledPrice{}
+	for _, price := range schedule.Prices {
+		prices = append(prices, scheduledPrice(price))
+	}
+
+	var diags diag.Diagnostics
+	data.Prices, diags = pricesValue(ctx, prices)
+	return diags
+}
+
+// setSchedule replaces the price schedule with the one in data, then records
+// the result in data.
+func (r *AppPriceScheduleResource) setSchedule(ctx context.Context, data *AppPriceScheduleResourceModel) diag.Diagnostics {
+	prices, diags := scheduledPricesFrom(ctx, data.Prices)
+	if diags.HasError() {
+		return diags
+	}
+
+	schedule := pricing.PriceSchedule{
+		AppID:         data.AppID.ValueString(),
+		BaseTerritory: data.BaseTerritory.ValueString(),
+	}
+	for _, price := range prices {
+		schedule.Prices = append(schedule.Prices, pricing.Price(price))
+	}
+
+	updated, err := r.client.SetAppPriceSchedule(ctx, schedule)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to set app price schedule, got error: %s", err))
+		return diags
+	}
+
+	diags.Append(r.populateState(ctx, data, updated)...)
+	return diags
+}
+
+func (r *AppPriceScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AppPriceScheduleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setSchedule(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "set an app price schedule")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppPriceScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppPriceScheduleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := r.client.GetAppPriceSchedule(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app price schedule, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, schedule)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppPriceScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AppPriceScheduleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setSchedule(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated an app price schedule")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppPriceScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Price schedules cannot be deleted, so they are only removed from state.
+	tflog.Trace(ctx, "removed app price schedule from state")
+}
+
+func (r *AppPriceScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
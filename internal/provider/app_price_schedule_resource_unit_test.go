@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/pricing"
+)
+
+type mockAppPriceScheduleClient struct {
+	set         *pricing.PriceSchedule
+	territories []string
+}
+
+func (m *mockAppPriceScheduleClient) GetAppPriceSchedule(ctx context.Context, appID string) (*pricing.PriceSchedule, error) {
+	return m.set, nil
+}
+
+func (m *mockAppPriceScheduleClient) SetAppPriceSchedule(ctx context.Context, schedule pricing.PriceSchedule) (*pricing.PriceSchedule, error) {
+	m.set = &schedule
+	return &schedule, nil
+}
+
+func (m *mockAppPriceScheduleClient) ListAppPricePoints(ctx context.Context, appID string, territory string) ([]pricing.PricePoint, error) {
+	m.territories = append(m.territories, territory)
+
+	points := map[string][]pricing.PricePoint{
+		"USA": {{ID: "usa-099"}, {ID: "usa-299"}},
+		"GBR": {{ID: "gbr-299"}},
+	}
+	return points[territory], nil
+}
+
+func appPriceScheduleResourceSchema() schema.Schema {
+	r := &AppPriceScheduleResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func appPriceScheduleVal(s schema.Schema, id interface{}, prices tftypes.Value) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":             tftypes.NewValue(tftypes.String, id),
+		"app_id":         tftypes.NewValue(tftypes.String, "app-id"),
+		"base_territory": tftypes.NewValue(tftypes.String, "USA"),
+		"price":          prices,
+	})
+}
+
+func TestAppPriceScheduleResource_Create_SetsSchedule(t *testing.T) {
+	client := &mockAppPriceScheduleClient{}
+	r := &AppPriceScheduleResource{client: client}
+
+	s := appPriceScheduleResourceSchema()
+	planVal := appPriceScheduleVal(s, nil, pricesVal(
+		priceVal("USA", "usa-299", nil),
+		priceVal("USA", "usa-099", "2026-11-27"),
+		priceVal("GBR", "gbr-299", nil),
+	))
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if client.set.AppID != "app-id" {
+		t.Errorf("expected app ID 'app-id', got %q", client.set.AppID)
+	}
+	if len(client.set.Prices) != 3 {
+		t.Errorf("expected 3 prices to be set, got %d", len(client.set.Prices))
+	}
+
+	var data AppPriceScheduleResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "app-id" {
+		t.Errorf("expected ID 'app-id', got %q", data.ID.ValueString())
+	}
+}
+
+func TestAppPriceScheduleResource_ModifyPlan_ChecksPricePointsExist(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		prices      tftypes.Value
+		expectError bool
+	}{
+		{name: "existing price points", prices: pricesVal(
+			priceVal("USA", "usa-299", nil),
+			priceVal("USA", "usa-099", "2026-11-27"),
+			priceVal("GBR", "gbr-299", nil),
+		)},
+		{name: "price point from another territory", prices: pricesVal(
+			priceVal("USA", "usa-299", nil),
+			priceVal("GBR", "usa-099", nil),
+		), expectError: true},
+		{name: "unknown price point", prices: pricesVal(
+			priceVal("USA", "usa-499", nil),
+		), expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &mockAppPriceScheduleClient{}
+			r := &AppPriceScheduleResource{client: client}
+
+			s := appPriceScheduleResourceSchema()
+			planVal := appPriceScheduleVal(s, nil, tc.prices)
+			req := resource.ModifyPlanRequest{
+				Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+			}
+			resp := &resource.ModifyPlanResponse{
+				Plan: req.Plan,
+			}
+
+			r.ModifyPlan(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error=%t, got diagnostics %v", tc.expectError, resp.Diagnostics)
+			}
+			if len(client.territories) > 2 {
+				t.Errorf("expected price points to be listed once per territory, got %v", client.territories)
+			}
+		})
+	}
+}
+
+func TestAppPriceScheduleResource_ModifyPlan_OnlyChecksChangedPrices(t *testing.T) {
+	client := &mockAppPriceScheduleClient{}
+	r := &AppPriceScheduleResource{client: client}
+
+	s := appPriceScheduleResourceSchema()
+	stateVal := appPriceScheduleVal(s, "app-id", pricesVal(
+		priceVal("USA", "usa-299", nil),
+		priceVal("GBR", "gbr-299", nil),
+	))
+
+	for _, tc := range []struct {
+		name            string
+		prices          tftypes.Value
+		wantTerritories []string
+	}{
+		{name: "unchanged", prices: pricesVal(
+			priceVal("USA", "usa-299", nil),
+			priceVal("GBR", "gbr-299", nil),
+		)},
+		{name: "new start date", prices: pricesVal(
+			priceVal("USA", "usa-299", "2026-11-27"),
+			priceVal("GBR", "gbr-299", nil),
+		)},
+		{name: "changed price", prices: pricesVal(
+			priceVal("USA", "usa-099", nil),
+			priceVal("GBR", "gbr-299", nil),
+		), wantTerritories: []string{"USA"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client.territories = nil
+
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: s, Raw: stateVal},
+				Plan:  tfsdk.Plan{Schema: s, Raw: appPriceScheduleVal(s, "app-id", tc.prices)},
+			}
+			resp := &resource.ModifyPlanResponse{
+				Plan: req.Plan,
+			}
+
+			r.ModifyPlan(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+			}
+			if !slices.Equal(client.territories, tc.wantTerritories) {
+				t.Errorf("expected price points to be listed for %v, got %v", tc.wantTerritories, client.territories)
+			}
+		})
+	}
+}
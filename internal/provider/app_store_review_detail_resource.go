@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/versions"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AppStoreReviewDetailResource{}
+var _ resource.ResourceWithImportState = &AppStoreReviewDetailResource{}
+var _ resource.ResourceWithValidateConfig = &AppStoreReviewDetailResource{}
+
+type appStoreReviewDetailClient interface {
+	FindReviewDetailByVersion(ctx context.Context, versionID string) (*versions.ReviewDetail, error)
+	GetReviewDetail(ctx context.Context, id string) (*versions.ReviewDetail, error)
+	CreateReviewDetail(ctx context.Context, detail versions.ReviewDetail) (*versions.ReviewDetail, error)
+	ModifyReviewDetail(ctx context.Context, id string, detail versions.ReviewDetail) (*versions.ReviewDetail, error)
+}
+
+func NewAppStoreReviewDetailResource() resource.Resource {
+	return &AppStoreReviewDetailResource{}
+}
+
+// AppStoreReviewDetailResource defines the resource implementation.
+type AppStoreReviewDetailResource struct {
+	client appStoreReviewDetailClient
+}
+
+// AppStoreReviewDetailResourceModel describes the resource data model.
+type AppStoreReviewDetailResourceModel struct {
+	ID                         types.String `tfsdk:"id"`
+	AppStoreVersionID          types.String `tfsdk:"app_store_version_id"`
+	ContactFirstName           types.String `tfsdk:"contact_first_name"`
+	ContactLastName            types.String `tfsdk:"contact_last_name"`
+	ContactPhone               types.String `tfsdk:"contact_phone"`
+	ContactEmail               types.String `tfsdk:"contact_email"`
+	DemoAccountRequired        types.Bool   `tfsdk:"demo_account_required"`
+	DemoAccountName            types.String `tfsdk:"demo_account_name"`
+	DemoAccountPasswordWO      types.String `tfsdk:"demo_account_password_wo"`
+	DemoAccountPasswordVersion types.Int64  `tfsdk:"demo_account_password_wo_version"`
+	Notes                      types.String `tfsdk:"notes"`
+}
+
+func (r *AppStoreReviewDetailResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_store_review_detail"
+}
+
+func (r *AppStoreReviewDetailResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the contact details, demo account and notes given to App Review for an App Store version.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the review details.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_store_version_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the App Store version the review details belong to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"contact_first_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The first name of the person App Review should contact.",
+			},
+			"contact_last_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The last name of the person App Review should contact.",
+			},
+			"contact_phone": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The phone number App Review should use, including the country code.",
+			},
+			"contact_email": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The email address App Review should use.",
+			},
+			"demo_account_required": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether App Review needs to sign in to review the app.",
+			},
+			"demo_account_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The user name of the demo account for App Review to sign in with.",
+			},
+			"demo_account_password_wo": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The password of the demo account. This value is never stored in state; increment `demo_account_password_wo_version` to send a new password.",
+			},
+			"demo_account_password_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "A version number for `demo_account_password_wo`. Changing it sends the current password to App Store Connect.",
+			},
+			"notes": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Additional information for App Review.",
+			},
+		},
+	}
+}
+
+func (r *AppStoreReviewDetailResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(appStoreReviewDetailClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected appStoreReviewDetailClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r AppStoreReviewDetailResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AppStoreReviewDetailResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without a version, Terraform has no way to tell that the password should be sent again.
+	if !data.DemoAccountPasswordWO.IsNull() && data.DemoAccountPasswordVersion.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("demo_account_password_wo_version"),
+			"Invalid Configuration",
+			"`demo_account_password_wo_version` must be set when `demo_account_password_wo` is provided.",
+		)
+	}
+}
+
+func (r *AppStoreReviewDetailResource) populateState(data *AppStoreReviewDetailResourceModel, detail *versions.ReviewDetail) {
+	data.ID = types.StringValue(detail.ID)
+	data.AppStoreVersionID = types.StringValue(detail.AppStoreVersionID)
+	data.ContactFirstName = optionalString(detail.ContactFirstName)
+	data.ContactLastName = optionalString(detail.ContactLastName)
+	data.ContactPhone = optionalString(detail.ContactPhone)
+	data.ContactEmail = optionalString(detail.ContactEmail)
+	data.DemoAccountRequired = types.BoolValue(detail.DemoAccountRequired)
+	data.DemoAccountName = optionalString(detail.DemoAccountName)
+	data.Notes = optionalString(detail.Notes)
+	data.DemoAccountPasswordWO = types.StringNull()
+}
+
+// reviewDetail builds the request body. The password is only included when
+// sendPassword is set, as an empty value leaves Apple's copy unchanged.
+func (r *AppStoreReviewDetailResource) reviewDetail(data *AppStoreReviewDetailResourceModel, password types.String, sendPassword bool) versions.ReviewDetail {
+	detail := versions.ReviewDetail{
+		AppStoreVersionID:   data.AppStoreVersionID.ValueString(),
+		ContactFirstName:    data.ContactFirstName.ValueString(),
+		ContactLastName:     data.ContactLastName.ValueString(),
+		ContactPhone:        data.ContactPhone.ValueString(),
+		ContactEmail:        data.ContactEmail.ValueString(),
+		DemoAccountRequired: data.DemoAccountRequired.ValueBool(),
+		DemoAccountName:     data.DemoAccountName.ValueString(),
+		Notes:               data.Notes.ValueString(),
+	}
+	if sendPassword {
+		detail.DemoAccountPassword = password.ValueString()
+	}
+	return detail
+}
+
+func (r *AppStoreReviewDetailResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AppStoreReviewDetailResourceModel
+	var password types.String
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only values are only available from the configuration.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("demo_account_password_wo"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := r.client.FindReviewDetailByVersion(ctx, data.AppStoreVersionID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read review details, got error: %s", err))
+		return
+	}
+
+	// Apple only allows one set of review details per version, so adopt any that exist.
+	var detail *versions.ReviewDetail
+	if existing != nil {
+		detail, err = r.client.ModifyReviewDetail(ctx, existing.ID, r.reviewDetail(&data, password, !password.IsNull()))
+	} else {
+		detail, err = r.client.CreateReviewDetail(ctx, r.reviewDetail(&data, password, !password.IsNull()))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create review details, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created review details")
+
+	r.populateState(&data, detail)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppStoreReviewDetailResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppStoreReviewDetailResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	detail, err := r.client.GetReviewDetail(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read review details, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, detail)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppStoreReviewDetailResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AppStoreReviewDetailResourceModel
+	var state AppStoreReviewDetailResourceModel
+	var password types.String
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("demo_account_password_wo"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sendPassword := !data.DemoAccountPasswordVersion.Equal(state.DemoAccountPasswordVersion)
+
+	detail, err := r.client.ModifyReviewDetail(ctx, data.ID.ValueString(), r.reviewDetail(&data, password, sendPassword))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify review details, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified review details")
+
+	r.populateState(&data, detail)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppStoreReviewDetailResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// App Store Connect does not allow review details to be deleted, so they
+	// are left attached to the version and only removed from state.
+	tflog.Trace(ctx, "removed review details from state")
+}
+
+func (r *AppStoreReviewDetailResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/versions"
+)
+
+type mockAppStoreReviewDetailClient struct {
+	existing *versions.ReviewDetail
+	created  *versions.ReviewDetail
+	modified *versions.ReviewDetail
+}
+
+func (m *mockAppStoreReviewDetailClient) FindReviewDetailByVersion(ctx context.Context, versionID string) (*versions.ReviewDetail, error) {
+	return m.existing, nil
+}
+
+func (m *mockAppStoreReviewDetailClient) GetReviewDetail(ctx context.Context, id string) (*versions.ReviewDetail, error) {
+	return &versions.ReviewDetail{ID: id}, nil
+}
+
+func (m *mockAppStoreReviewDetailClient) CreateReviewDetail(ctx context.Context, detail versions.ReviewDetail) (*versions.ReviewDetail, error) {
+	m.created = &detail
+	detail.ID = "detail-id"
+	return &detail, nil
+}
+
+func (m *mockAppStoreReviewDetailClient) ModifyReviewDetail(ctx context.Context, id string, detail versions.ReviewDetail) (*versions.ReviewDetail, error) {
+	m.modified = &detail
+	detail.ID = id
+	return &detail, nil
+}
+
+func appStoreReviewDetailResourceSchema() schema.Schema {
+	r := &AppStoreReviewDetailResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func appStoreReviewDetailVal(s schema.Schema, id interface{}, password interface{}, passwordVersion interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                               tftypes.NewValue(tftypes.String, id),
+		"app_store_version_id":             tftypes.NewValue(tftypes.String, "version-id"),
+		"contact_first_name":               tftypes.NewValue(tftypes.String, "Oliver"),
+		"contact_last_name":                tftypes.NewValue(tftypes.String, "Binns"),
+		"contact_phone":                    tftypes.NewValue(tftypes.String, nil),
+		"contact_email":                    tftypes.NewValue(tftypes.String, "mail@oliverbinns.co.uk"),
+		"demo_account_required":            tftypes.NewValue(tftypes.Bool, true),
+		"demo_account_name":                tftypes.NewValue(tftypes.String, "reviewer@example.com"),
+		"demo_account_password_wo":         tftypes.NewValue(tftypes.String, password),
+		"demo_account_password_wo_version": tftypes.NewValue(tftypes.Number, passwordVersion),
+		"notes":                            tftypes.NewValue(tftypes.String, nil),
+	})
+}
+
+func TestAppStoreReviewDetailResource_Create_SendsPasswordWithoutStoringIt(t *testing.T) {
+	client := &mockAppStoreReviewDetailClient{}
+	r := &AppStoreReviewDetailResource{client: client}
+
+	s := appStoreReviewDetailResourceSchema()
+	configVal := appStoreReviewDetailVal(s, nil, "hunter2", 1)
+	planVal := appStoreReviewDetailVal(s, nil, nil, 1)
+
+	req := resource.CreateRequest{
+		Config: tfsdk.Config{Schema: s, Raw: configVal},
+		Plan:   tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if client.created == nil || client.created.DemoAccountPassword != "hunter2" {
+		t.Fatalf("expected CreateReviewDetail to receive the demo account password")
+	}
+
+	var data AppStoreReviewDetailResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if !data.DemoAccountPasswordWO.IsNull() {
+		t.Errorf("expected the demo account password not to be stored in state")
+	}
+	if data.ID.ValueString() != "detail-id" {
+		t.Errorf("expected ID 'detail-id', got %q", data.ID.ValueString())
+	}
+}
+
+func TestAppStoreReviewDetailResource_Update_OnlySendsPasswordWhenVersionChanges(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		planVersion  int
+		expectedSent string
+	}{
+		{name: "unchanged", planVersion: 1, expectedSent: ""},
+		{name: "incremented", planVersion: 2, expectedSent: "hunter3"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &mockAppStoreReviewDetailClient{}
+			r := &AppStoreReviewDetailResource{client: client}
+
+			s := appStoreReviewDetailResourceSchema()
+			stateVal := appStoreReviewDetailVal(s, "detail-id", nil, 1)
+			configVal := appStoreReviewDetailVal(s, "detail-id", "hunter3", tc.planVersion)
+			planVal := appStoreReviewDetailVal(s, "detail-id", nil, tc.planVersion)
+
+			req := resource.UpdateRequest{
+				Config: tfsdk.Config{Schema: s, Raw: configVal},
+				Plan:   tfsdk.Plan{Schema: s, Raw: planVal},
+				State:  tfsdk.State{Schema: s, Raw: stateVal},
+			}
+			resp := &resource.UpdateResponse{
+				State: tfsdk.State{Schema: s, Raw: stateVal},
+			}
+
+			r.Update(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+			}
+			if client.modified.DemoAccountPassword != tc.expectedSent {
+				t.Errorf("expected password %q to be sent, got %q", tc.expectedSent, client.modified.DemoAccountPassword)
+			}
+		})
+	}
+}
+
+func TestAppStoreReviewDetailResource_ValidateConfig_RequiresPasswordVersion(t *testing.T) {
+	r := AppStoreReviewDetailResource{}
+
+	s := appStoreReviewDetailResourceSchema()
+	req := resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: s, Raw: appStoreReviewDetailVal(s, nil, "hunter2", nil)},
+	}
+	resp := &resource.ValidateConfigResponse{}
+
+	r.ValidateConfig(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when the password is set without a version")
+	}
+}
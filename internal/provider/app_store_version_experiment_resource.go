@@ -0,0 +1,393 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/experiments"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AppStoreVersionExperimentResource{}
+var _ resource.ResourceWithImportState = &AppStoreVersionExperimentResource{}
+var _ resource.ResourceWithValidateConfig = &AppStoreVersionExperimentResource{}
+var _ resource.ResourceWithModifyPlan = &AppStoreVersionExperimentResource{}
+
+// experimentStates are the states that can be requested in configuration.
+// The provider reports `NOT_STARTED` until the experiment is started, but it
+// cannot be set.
+var experimentStates = []string{"STARTED", "STOPPED"}
+
+// approvedExperimentReviewStates are the review states in which Apple allows
+// an experiment to be started.
+var approvedExperimentReviewStates = []string{"ACCEPTED", "APPROVED"}
+
+// endedExperimentReviewStates are the review states Apple reports once an
+// experiment has been stopped or has run its course.
+var endedExperimentReviewStates = []string{"STOPPED", "COMPLETED"}
+
+type appStoreVersionExperimentClient interface {
+	CreateAppStoreVersionExperiment(ctx context.Context, experiment experiments.Experiment) (*experiments.Experiment, error)
+	GetAppStoreVersionExperiment(ctx context.Context, id string) (*experiments.Experiment, error)
+	ModifyAppStoreVersionExperiment(ctx context.Context, id string, experiment experiments.Experiment) (*experiments.Experiment, error)
+	SetAppStoreVersionExperimentStarted(ctx context.Context, id string, started bool) (*experiments.Experiment, error)
+	DeleteAppStoreVersionExperiment(ctx context.Context, id string) error
+}
+
+func NewAppStoreVersionExperimentResource() resource.Resource {
+	return &AppStoreVersionExperimentResource{}
+}
+
+// AppStoreVersionExperimentResource defines the resource implementation.
+type AppStoreVersionExperimentResource struct {
+	client appStoreVersionExperimentClient
+}
+
+// AppStoreVersionExperimentResourceModel describes the resource data model.
+type AppStoreVersionExperimentResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	AppID             types.String `tfsdk:"app_id"`
+	Platform          types.String `tfsdk:"platform"`
+	Name              types.String `tfsdk:"name"`
+	TrafficProportion types.Int64  `tfsdk:"traffic_proportion"`
+	State             types.String `tfsdk:"state"`
+	ReviewState       types.String `tfsdk:"review_state"`
+	StartDate         types.String `tfsdk:"start_date"`
+	EndDate           types.String `tfsdk:"end_date"`
+}
+
+func (r *AppStoreVersionExperimentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_store_version_experiment"
+}
+
+func (r *AppStoreVersionExperimentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a product page optimization experiment, which shows alternative app icons, screenshots " +
+			"and previews to a share of App Store visitors. Add treatments with `appstoreconnect_app_store_version_experiment_treatment`, " +
+			"submit the experiment for review, then set `state` to `STARTED` once it has been approved.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the experiment.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the app the experiment belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"platform": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The platform of the product page under test: `IOS`, `MAC_OS`, `TV_OS` or `VISION_OS`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name used for the experiment in App Store Connect.",
+			},
+			"traffic_proportion": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The percentage of visitors, from 1 to 100, who are shown a treatment instead of the original product page. It is split evenly between treatments.",
+			},
+			"state": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the experiment is running: `STARTED` or `STOPPED`. The provider reports `NOT_STARTED` until the experiment is started. A `STARTED` experiment is started on the first apply after Apple approves it, and a stopped experiment can't be restarted.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"review_state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The state Apple reports for the experiment, e.g. `PREPARE_FOR_SUBMISSION`, `ACCEPTED` or `COMPLETED`.",
+			},
+			"start_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date the experiment started.",
+			},
+			"end_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date the experiment ended.",
+			},
+		},
+	}
+}
+
+func (r *AppStoreVersionExperimentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(appStoreVersionExperimentClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected appStoreVersionExperimentClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r AppStoreVersionExperimentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AppStoreVersionExperimentResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Platform.IsNull() && !data.Platform.IsUnknown() && !slices.Contains(buildPlatforms, data.Platform.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("platform"),
+			"Invalid Configuration",
+			fmt.Sprintf("`platform` must be one of %s, got %q.", strings.Join(buildPlatforms, ", "), data.Platform.ValueString()),
+		)
+	}
+
+	if !data.TrafficProportion.IsNull() && !data.TrafficProportion.IsUnknown() {
+		if proportion := data.TrafficProportion.ValueInt64(); proportion < 1 || proportion > 100 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("traffic_proportion"),
+				"Invalid Configuration",
+				fmt.Sprintf("`traffic_proportion` must be between 1 and 100, got %d.", proportion),
+			)
+		}
+	}
+
+	if !data.State.IsNull() && !data.State.IsUnknown() && !slices.Contains(experimentStates, data.State.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("state"),
+			"Invalid Configuration",
+			fmt.Sprintf("`state` must be one of %s, got %q.", strings.Join(experimentStates, ", "), data.State.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan warns that a new experiment can only be started once Apple has
+// approved it, and rejects plans that create a stopped experiment or restart
+// a stopped experiment, which Apple doesn't allow.
+func (r AppStoreVersionExperimentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("state"), &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		switch plan.ValueString() {
+		case "STARTED":
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("state"),
+				"Experiment start deferred",
+				"A new experiment must be approved by App Review before it can be started. It will be started on the first apply after it has been approved.",
+			)
+		case "STOPPED":
+			resp.Diagnostics.AddAttributeError(
+				path.Root("state"),
+				"Invalid Configuration",
+				"A new experiment can't be created stopped. Set `state` to `STARTED`, or leave it unset.",
+			)
+		}
+		return
+	}
+
+	var state types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("state"), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ValueString() == "STOPPED" && plan.ValueString() == "STARTED" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("state"),
+			"Invalid Configuration",
+			"The experiment has ended and cannot be restarted. Set `state` to `STOPPED`, or replace the experiment to run it again.",
+		)
+	}
+}
+
+// experimentState describes whether experiment is running, in the terms
+// accepted by the `state` attribute.
+func experimentState(experiment *experiments.Experiment) string {
+	switch {
+	case slices.Contains(endedExperimentReviewStates, experiment.State):
+		return "STOPPED"
+	case experiment.Started:
+		return "STARTED"
+	}
+	return "NOT_STARTED"
+}
+
+func (r *AppStoreVersionExperimentResource) populateState(data *AppStoreVersionExperimentResourceModel, experiment *experiments.Experiment) {
+	data.ID = types.StringValue(experiment.ID)
+	data.AppID = types.StringValue(experiment.AppID)
+	data.Platform = types.StringValue(experiment.Platform)
+	data.Name = types.StringValue(experiment.Name)
+	data.TrafficProportion = types.Int64Value(experiment.TrafficProportion)
+	// A requested start waits for App Review, so keep reporting it until the
+	// experiment is approved, when the difference is planned as a start.
+	state := experimentState(experiment)
+	waiting := data.State.ValueString() == "STARTED" && state == "NOT_STARTED" && !slices.Contains(approvedExperimentReviewStates, experiment.State)
+	if !waiting {
+		data.State = types.StringValue(state)
+	}
+	data.ReviewState = types.StringValue(experiment.State)
+	data.StartDate = optionalString(experiment.StartDate)
+	data.EndDate = optionalString(experiment.EndDate)
+}
+
+// applyState starts or stops experiment to match the `state` in data, if it
+// was set and differs. A start is deferred until the experiment is approved.
+func (r *AppStoreVersionExperimentResource) applyState(ctx context.Context, data *AppStoreVersionExperimentResourceModel, experiment *experiments.Experiment) (*experiments.Experiment, error) {
+	if data.State.IsNull() || data.State.IsUnknown() || data.State.ValueString() == experimentState(experiment) {
+		return experiment, nil
+	}
+	if data.State.ValueString() == "STARTED" && !slices.Contains(approvedExperimentReviewStates, experiment.State) {
+		return experiment, nil
+	}
+
+	return r.client.SetAppStoreVersionExperimentStarted(ctx, experiment.ID, data.State.ValueString() == "STARTED")
+}
+
+func (r *AppStoreVersionExperimentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AppStoreVersionExperimentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	experiment, err := r.client.CreateAppStoreVersionExperiment(ctx, experiments.Experiment{
+		AppID:             data.AppID.ValueString(),
+		Platform:          data.Platform.ValueString(),
+		Name:              data.Name.ValueString(),
+		TrafficProportion: data.TrafficProportion.ValueInt64(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create app store version experiment, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an app store version experiment")
+
+	experiment, err = r.applyState(ctx, &data, experiment)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to start app store version experiment, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, experiment)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppStoreVersionExperimentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppStoreVersionExperimentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	experiment, err := r.client.GetAppStoreVersionExperiment(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app store version experiment, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, experiment)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppStoreVersionExperimentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AppStoreVersionExperimentResourceModel
+	var state AppStoreVersionExperimentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apple rejects most changes once an experiment is running, so only send
+	// the attributes that changed and leave the rest untouched.
+	var modified experiments.Experiment
+	changed := false
+	if !data.Name.Equal(state.Name) {
+		modified.Name = data.Name.ValueString()
+		changed = true
+	}
+	if !data.TrafficProportion.Equal(state.TrafficProportion) {
+		modified.TrafficProportion = data.TrafficProportion.ValueInt64()
+		changed = true
+	}
+
+	var experiment *experiments.Experiment
+	var err error
+	if !changed {
+		experiment, err = r.client.GetAppStoreVersionExperiment(ctx, data.ID.ValueString())
+	} else {
+		experiment, err = r.client.ModifyAppStoreVersionExperiment(ctx, data.ID.ValueString(), modified)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify app store version experiment, got error: %s", err))
+		return
+	}
+
+	experiment, err = r.applyState(ctx, &data, experiment)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to change app store version experiment state, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified an app store version experiment")
+
+	r.populateState(&data, experiment)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppStoreVersionExperimentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AppStoreVersionExperimentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAppStoreVersionExperiment(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete app store version experiment, got error: %s", err))
+		return
+	}
+}
+
+func (r *AppStoreVersionExperimentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
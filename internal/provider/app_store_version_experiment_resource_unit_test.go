@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/experiments"
+)
+
+type mockAppStoreVersionExperimentClient struct {
+	experiment experiments.Experiment
+	modified   []experiments.Experiment
+	started    []bool
+}
+
+func (m *mockAppStoreVersionExperimentClient) CreateAppStoreVersionExperiment(ctx context.Context, experiment experiments.Experiment) (*experiments.Experiment, error) {
+	experiment.ID = "experiment-id"
+	experiment.State = "PREPARE_FOR_SUBMISSION"
+	m.experiment = experiment
+	return &experiment, nil
+}
+
+func (m *mockAppStoreVersionExperimentClient) GetAppStoreVersionExperiment(ctx context.Context, id string) (*experiments.Experiment, error) {
+	experiment := m.experiment
+	return &experiment, nil
+}
+
+func (m *mockAppStoreVersionExperimentClient) ModifyAppStoreVersionExperiment(ctx context.Context, id string, experiment experiments.Experiment) (*experiments.Experiment, error) {
+	m.modified = append(m.modified, experiment)
+	if experiment.Name != "" {
+		m.experiment.Name = experiment.Name
+	}
+	if experiment.TrafficProportion != 0 {
+		m.experiment.TrafficProportion = experiment.TrafficProportion
+	}
+	updated := m.experiment
+	return &updated, nil
+}
+
+func (m *mockAppStoreVersionExperimentClient) SetAppStoreVersionExperimentStarted(ctx context.Context, id string, started bool) (*experiments.Experiment, error) {
+	m.started = append(m.started, started)
+	m.experiment.Started = started
+	if !started {
+		m.experiment.State = "STOPPED"
+	}
+	updated := m.experiment
+	return &updated, nil
+}
+
+func (m *mockAppStoreVersionExperimentClient) DeleteAppStoreVersionExperiment(ctx context.Context, id string) error {
+	return nil
+}
+
+func appStoreVersionExperimentResourceSchema() schema.Schema {
+	r := &AppStoreVersionExperimentResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func appStoreVersionExperimentVal(s schema.Schema, platform string, trafficProportion int64, state interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                 tftypes.NewValue(tftypes.String, "experiment-id"),
+		"app_id":             tftypes.NewValue(tftypes.String, "app-id"),
+		"platform":           tftypes.NewValue(tftypes.String, platform),
+		"name":               tftypes.NewValue(tftypes.String, "Icon test"),
+		"traffic_proportion": tftypes.NewValue(tftypes.Number, trafficProportion),
+		"state":              tftypes.NewValue(tftypes.String, state),
+		"review_state":       tftypes.NewValue(tftypes.String, nil),
+		"start_date":         tftypes.NewValue(tftypes.String, nil),
+		"end_date":           tftypes.NewValue(tftypes.String, nil),
+	})
+}
+
+func TestAppStoreVersionExperimentResource_Create_NotStarted(t *testing.T) {
+	client := &mockAppStoreVersionExperimentClient{}
+	r := &AppStoreVersionExperimentResource{client: client}
+
+	s := appStoreVersionExperimentResourceSchema()
+	planVal := appStoreVersionExperimentVal(s, "IOS", 25, tftypes.UnknownValue)
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.started) != 0 {
+		t.Errorf("expected the experiment not to be started, got %v", client.started)
+	}
+
+	var data AppStoreVersionExperimentResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.State.ValueString() != "NOT_STARTED" || data.ReviewState.ValueString() != "PREPARE_FOR_SUBMISSION" {
+		t.Errorf("expected NOT_STARTED in PREPARE_FOR_SUBMISSION, got %q in %q", data.State.ValueString(), data.ReviewState.ValueString())
+	}
+	if data.TrafficProportion.ValueInt64() != 25 {
+		t.Errorf("expected traffic proportion 25, got %d", data.TrafficProportion.ValueInt64())
+	}
+}
+
+func TestAppStoreVersionExperimentResource_Update_StopsExperiment(t *testing.T) {
+	client := &mockAppStoreVersionExperimentClient{
+		experiment: experiments.Experiment{ID: "experiment-id", AppID: "app-id", Platform: "IOS", Name: "Icon test", TrafficProportion: 25, State: "APPROVED", Started: true},
+	}
+	r := &AppStoreVersionExperimentResource{client: client}
+
+	s := appStoreVersionExperimentResourceSchema()
+	stateVal := appStoreVersionExperimentVal(s, "IOS", 25, "STARTED")
+	planVal := appStoreVersionExperimentVal(s, "IOS", 25, "STOPPED")
+
+	req := resource.UpdateRequest{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Update(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.started) != 1 || client.started[0] {
+		t.Errorf("expected the experiment to be stopped, got %v", client.started)
+	}
+	if len(client.modified) != 0 {
+		t.Errorf("expected no other changes to be sent, got %v", client.modified)
+	}
+
+	var data AppStoreVersionExperimentResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.State.ValueString() != "STOPPED" {
+		t.Errorf("expected state STOPPED, got %q", data.State.ValueString())
+	}
+}
+
+func TestAppStoreVersionExperimentResource_Update_SendsOnlyChangedAttributes(t *testing.T) {
+	client := &mockAppStoreVersionExperimentClient{
+		experiment: experiments.Experiment{ID: "experiment-id", AppID: "app-id", Platform: "IOS", Name: "Icon test", TrafficProportion: 25, State: "PREPARE_FOR_SUBMISSION"},
+	}
+	r := &AppStoreVersionExperimentResource{client: client}
+
+	s := appStoreVersionExperimentResourceSchema()
+	stateVal := appStoreVersionExperimentVal(s, "IOS", 25, "NOT_STARTED")
+	planVal := appStoreVersionExperimentVal(s, "IOS", 50, "NOT_STARTED")
+
+	req := resource.UpdateRequest{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Update(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.modified) != 1 || client.modified[0].Name != "" || client.modified[0].TrafficProportion != 50 {
+		t.Errorf("expected only the traffic proportion to be sent, got %v", client.modified)
+	}
+
+	var data AppStoreVersionExperimentResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.Name.ValueString() != "Icon test" || data.TrafficProportion.ValueInt64() != 50 {
+		t.Errorf("expected 'Icon test' with traffic proportion 50, got %q with %d", data.Name.ValueString(), data.TrafficProportion.ValueInt64())
+	}
+}
+
+func TestAppStoreVersionExperimentResource_ModifyPlan_DefersStartOnCreate(t *testing.T) {
+	r := AppStoreVersionExperimentResource{}
+
+	s := appStoreVersionExperimentResourceSchema()
+	planVal := appStoreVersionExperimentVal(s, "IOS", 25, "STARTED")
+
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)},
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.ModifyPlanResponse{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+
+	r.ModifyPlan(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("expected a warning that the start is deferred, got %v", resp.Diagnostics)
+	}
+}
+
+func TestAppStoreVersionExperimentResource_Create_DefersStartUntilApproved(t *testing.T) {
+	client := &mockAppStoreVersionExperimentClient{}
+	r := &AppStoreVersionExperimentResource{client: client}
+
+	s := appStoreVersionExperimentResourceSchema()
+	planVal := appStoreVersionExperimentVal(s, "IOS", 25, "STARTED")
+
+	createResp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+	r.Create(context.Background(), resource.CreateRequest{Plan: tfsdk.Plan{Schema: s, Raw: planVal}}, createResp)
+
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", createResp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.started) != 0 {
+		t.Errorf("expected the unreviewed experiment not to be started, got %v", client.started)
+	}
+
+	var data AppStoreVersionExperimentResourceModel
+	createResp.State.Get(context.Background(), &data)
+
+	if data.State.ValueString() != "STARTED" {
+		t.Errorf("expected the requested state 'STARTED' to be kept, got %q", data.State.ValueString())
+	}
+
+	// Once approved, a refresh reports the experiment as not started so the next apply starts it.
+	client.experiment.State = "APPROVED"
+	readResp := &resource.ReadResponse{
+		State: createResp.State,
+	}
+	r.Read(context.Background(), resource.ReadRequest{State: createResp.State}, readResp)
+
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", readResp.Diagnostics.Errors()[0].Detail())
+	}
+	readResp.State.Get(context.Background(), &data)
+
+	if data.State.ValueString() != "NOT_STARTED" {
+		t.Errorf("expected state 'NOT_STARTED' once approved, got %q", data.State.ValueString())
+	}
+}
+
+func TestAppStoreVersionExperimentResource_ModifyPlan_RejectsRestart(t *testing.T) {
+	r := AppStoreVersionExperimentResource{}
+
+	s := appStoreVersionExperimentResourceSchema()
+	stateVal := appStoreVersionExperimentVal(s, "IOS", 25, "STOPPED")
+	planVal := appStoreVersionExperimentVal(s, "IOS", 25, "STARTED")
+
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.ModifyPlanResponse{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+
+	r.ModifyPlan(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error when restarting a stopped experiment")
+	}
+}
+
+func TestAppStoreVersionExperimentResource_ValidateConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name              string
+		platform          string
+		trafficProportion int64
+		state             interface{}
+		expectError       bool
+	}{
+		{name: "valid", platform: "IOS", trafficProportion: 50},
+		{name: "started", platform: "MAC_OS", trafficProportion: 100, state: "STARTED"},
+		{name: "unknown platform", platform: "WATCH_OS", trafficProportion: 50, expectError: true},
+		{name: "no traffic", platform: "IOS", trafficProportion: 0, expectError: true},
+		{name: "too much traffic", platform: "IOS", trafficProportion: 101, expectError: true},
+		{name: "unsettable state", platform: "IOS", trafficProportion: 50, state: "NOT_STARTED", expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := AppStoreVersionExperimentResource{}
+
+			s := appStoreVersionExperimentResourceSchema()
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: appStoreVersionExperimentVal(s, tc.platform, tc.trafficProportion, tc.state)},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error=%t, got diagnostics %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/experiments"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AppStoreVersionExperimentTreatmentResource{}
+var _ resource.ResourceWithImportState = &AppStoreVersionExperimentTreatmentResource{}
+var _ resource.ResourceWithValidateConfig = &AppStoreVersionExperimentTreatmentResource{}
+var _ resource.ResourceWithModifyPlan = &AppStoreVersionExperimentTreatmentResource{}
+
+type appStoreVersionExperimentTreatmentClient interface {
+	CreateAppStoreVersionExperimentTreatment(ctx context.Context, treatment experiments.Treatment) (*experiments.Treatment, error)
+	GetAppStoreVersionExperimentTreatment(ctx context.Context, id string) (*experiments.Treatment, error)
+	ModifyAppStoreVersionExperimentTreatment(ctx context.Context, id string, treatment experiments.Treatment) (*experiments.Treatment, error)
+	DeleteAppStoreVersionExperimentTreatment(ctx context.Context, id string) error
+	ListAppStoreVersionExperimentTreatmentLocalizations(ctx context.Context, treatmentID string) ([]experiments.TreatmentLocalization, error)
+	CreateAppStoreVersionExperimentTreatmentLocalization(ctx context.Context, localization experiments.TreatmentLocalization) (*experiments.TreatmentLocalization, error)
+	DeleteAppStoreVersionExperimentTreatmentLocalization(ctx context.Context, id string) error
+}
+
+func NewAppStoreVersionExperimentTreatmentResource() resource.Resource {
+	return &AppStoreVersionExperimentTreatmentResource{}
+}
+
+// AppStoreVersionExperimentTreatmentResource defines the resource implementation.
+type AppStoreVersionExperimentTreatmentResource struct {
+	client appStoreVersionExperimentTreatmentClient
+}
+
+// AppStoreVersionExperimentTreatmentResourceModel describes the resource data model.
+type AppStoreVersionExperimentTreatmentResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	ExperimentID    types.String `tfsdk:"experiment_id"`
+	Name            types.String `tfsdk:"name"`
+	AppIconName     types.String `tfsdk:"app_icon_name"`
+	Locales         types.Set    `tfsdk:"locales"`
+	LocalizationIDs types.Map    `tfsdk:"localization_ids"`
+}
+
+func (r *AppStoreVersionExperimentTreatmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_store_version_experiment_treatment"
+}
+
+func (r *AppStoreVersionExperimentTreatmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a treatment of a product page optimization experiment: an alternative app icon and, " +
+			"for each locale, alternative screenshots and previews. Attach them with `appstoreconnect_screenshot_set` and " +
+			"`appstoreconnect_app_preview_set`, using an ID from `localization_ids`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the treatment.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"experiment_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the experiment the treatment belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name used for the treatment in App Store Connect.",
+			},
+			"app_icon_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of an alternate app icon included in the app's binary to show in this treatment.",
+			},
+			"locales": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The locales the treatment has its own screenshots and previews in, e.g. `en-GB`.",
+			},
+			"localization_ids": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The identifiers of the treatment's localizations, keyed by locale.",
+			},
+		},
+	}
+}
+
+func (r *AppStoreVersionExperimentTreatmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(appStoreVersionExperimentTreatmentClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected appStoreVersionExperimentTreatmentClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r AppStoreVersionExperimentTreatmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AppStoreVersionExperimentTreatmentResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Locales.IsNull() && !data.Locales.IsUnknown() && len(data.Locales.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("locales"),
+			"Invalid Configuration",
+			"At least one locale is required.",
+		)
+	}
+}
+
+// ModifyPlan keeps the localization IDs when the locales don't change, so
+// that screenshot sets attached to them aren't replaced.
+func (r AppStoreVersionExperimentTreatmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to keep when the resource is being created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state AppStoreVersionExperimentTreatmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Locales.Equal(state.Locales) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("localization_ids"), state.LocalizationIDs)...)
+	}
+}
+
+func (r *AppStoreVersionExperimentTreatmentResource) populateState(ctx context.Context, data *AppStoreVersionExperimentTreatmentResourceModel, treatment *experiments.Treatment, localizations []experiments.TreatmentLocalization) diag.Diagnostics {
+	data.ID = types.StringValue(treatment.ID)
+	data.ExperimentID = types.StringValue(treatment.ExperimentID)
+	data.Name = types.StringValue(treatment.Name)
+	data.AppIconName = optionalString(treatment.AppIconName)
+
+	locales := []string{}
+	ids := map[string]string{}
+	for _, localization := range localizations {
+		locales = append(locales, localization.Locale)
+		ids[localization.Locale] = localization.ID
+	}
+	slices.Sort(locales)
+
+	var diags, d diag.Diagnostics
+	data.Locales, d = types.SetValueFrom(ctx, types.StringType, locales)
+	diags.Append(d...)
+	data.LocalizationIDs, d = types.MapValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	return diags
+}
+
+// syncLocalizations creates and deletes localizations so that the treatment
+// has one for each locale in data, and records the result, along with the
+// treatment, in data.
+func (r *AppStoreVersionExperimentTreatmentResource) syncLocalizations(ctx context.Context, data *AppStoreVersionExperimentTreatmentResourceModel, treatment *experiments.Treatment) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var planned []string
+	diags.Append(data.Locales.ElementsAs(ctx, &planned, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	existing, err := r.client.ListAppStoreVersionExperimentTreatmentLocalizations(ctx, treatment.ID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list treatment localizations, got error: %s", err))
+		return diags
+	}
+
+	current := map[string]experiments.TreatmentLocalization{}
+	for _, localization := range existing {
+		current[localization.Locale] = localization
+	}
+
+	for _, locale := range planned {
+		if _, ok := current[locale]; ok {
+			delete(current, locale)
+			continue
+		}
+
+		_, err := r.client.CreateAppStoreVersionExperimentTreatmentLocalization(ctx, experiments.TreatmentLocalization{
+			TreatmentID: treatment.ID,
+			Locale:      locale,
+		})
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to create %s localization, got error: %s", locale, err))
+			return diags
+		}
+	}
+
+	for locale, localization := range current {
+		if err := r.client.DeleteAppStoreVersionExperimentTreatmentLocalization(ctx, localization.ID); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete %s localization, got error: %s", locale, err))
+			return diags
+		}
+	}
+
+	existing, err = r.client.ListAppStoreVersionExperimentTreatmentLocalizations(ctx, treatment.ID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list treatment localizations, got error: %s", err))
+		return diags
+	}
+
+	diags.Append(r.populateState(ctx, data, treatment, existing)...)
+	return diags
+}
+
+func (r *AppStoreVersionExperimentTreatmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AppStoreVersionExperimentTreatmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	treatment, err := r.client.CreateAppStoreVersionExperimentTreatment(ctx, experiments.Treatment{
+		ExperimentID: data.ExperimentID.ValueString(),
+		Name:         data.Name.ValueString(),
+		AppIconName:  data.AppIconName.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create app store version experiment treatment, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an app store version experiment treatment")
+
+	// Save the treatment before adding its localizations.
+	created := data
+	resp.Diagnostics.Append(r.populateState(ctx, &created, treatment, nil)...)
+	resp.Diagnostics.Append(saveCreated(ctx, &resp.State, &created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncLocalizations(ctx, &data, treatment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppStoreVersionExperimentTreatmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppStoreVersionExperimentTreatmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	treatment, err := r.client.GetAppStoreVersionExperimentTreatment(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app store version experiment treatment, got error: %s", err))
+		return
+	}
+
+	localizations, err := r.client.ListAppStoreVersionExperimentTreatmentLocalizations(ctx, treatment.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list treatment localizations, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, treatment, localizations)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppStoreVersionExperimentTreatmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AppStoreVersionExperimentTreatmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	treatment, err := r.client.ModifyAppStoreVersionExperimentTreatment(ctx, data.ID.ValueString(), experiments.Treatment{
+		Name:        data.Name.ValueString(),
+		AppIconName: data.AppIconName.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify app store version experiment treatment, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified an app store version experiment treatment")
+
+	resp.Diagnostics.Append(r.syncLocalizations(ctx, &data, treatment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppStoreVersionExperimentTreatmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AppStoreVersionExperimentTreatmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAppStoreVersionExperimentTreatment(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete app store version experiment treatment, got error: %s", err))
+		return
+	}
+}
+
+func (r *AppStoreVersionExperimentTreatmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
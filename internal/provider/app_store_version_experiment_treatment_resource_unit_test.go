@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/experiments"
+)
+
+type mockAppStoreVersionExperimentTreatmentClient struct {
+	localizations []experiments.TreatmentLocalization
+	deletedIDs    []string
+	nextID        int
+	createErr     error
+}
+
+func (m *mockAppStoreVersionExperimentTreatmentClient) CreateAppStoreVersionExperimentTreatment(ctx context.Context, treatment experiments.Treatment) (*experiments.Treatment, error) {
+	treatment.ID = "treatment-id"
+	return &treatment, nil
+}
+
+func (m *mockAppStoreVersionExperimentTreatmentClient) GetAppStoreVersionExperimentTreatment(ctx context.Context, id string) (*experiments.Treatment, error) {
+	return &experiments.Treatment{ID: id}, nil
+}
+
+func (m *mockAppStoreVersionExperimentTreatmentClient) ModifyAppStoreVersionExperimentTreatment(ctx context.Context, id string, treatment experiments.Treatment) (*experiments.Treatment, error) {
+	treatment.ID = id
+	treatment.ExperimentID = "experiment-id"
+	return &treatment, nil
+}
+
+func (m *mockAppStoreVersionExperimentTreatmentClient) DeleteAppStoreVersionExperimentTreatment(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *mockAppStoreVersionExperimentTreatmentClient) ListAppStoreVersionExperimentTreatmentLocalizations(ctx context.Context, treatmentID string) ([]experiments.TreatmentLocalization, error) {
+	return m.localizations, nil
+}
+
+func (m *mockAppStoreVersionExperimentTreatmentClient) CreateAppStoreVersionExperimentTreatmentLocalization(ctx context.Context, localization experiments.TreatmentLocalization) (*experiments.TreatmentLocalization, error) {
+	if m.createErr != nil {
+		return nil, m.createErr
+	}
+	m.nextID++
+	localization.ID = fmt.Sprintf("localization-%d", m.nextID)
+	m.localizations = append(m.localizations, localization)
+	return &localization, nil
+}
+
+func (m *mockAppStoreVersionExperimentTreatmentClient) DeleteAppStoreVersionExperimentTreatmentLocalization(ctx context.Context, id string) error {
+	m.deletedIDs = append(m.deletedIDs, id)
+	for i := range m.localizations {
+		if m.localizations[i].ID == id {
+			m.localizations = append(m.localizations[:i], m.localizations[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func appStoreVersionExperimentTreatmentResourceSchema() schema.Schema {
+	r := &AppStoreVersionExperimentTreatmentResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func appStoreVersionExperimentTreatmentVal(s schema.Schema, name string, localizationIDs tftypes.Value, locales ...string) tftypes.Value {
+	localeVals := []tftypes.Value{}
+	for _, locale := range locales {
+		localeVals = append(localeVals, tftypes.NewValue(tftypes.String, locale))
+	}
+
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":               tftypes.NewValue(tftypes.String, "treatment-id"),
+		"experiment_id":    tftypes.NewValue(tftypes.String, "experiment-id"),
+		"name":             tftypes.NewValue(tftypes.String, name),
+		"app_icon_name":    tftypes.NewValue(tftypes.String, "DarkIcon"),
+		"locales":          tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, localeVals),
+		"localization_ids": localizationIDs,
+	})
+}
+
+func TestAppStoreVersionExperimentTreatmentResource_Create_SavesTreatmentWhenLocalizationsFail(t *testing.T) {
+	client := &mockAppStoreVersionExperimentTreatmentClient{createErr: fmt.Errorf("service unavailable")}
+	r := &AppStoreVersionExperimentTreatmentResource{client: client}
+
+	s := appStoreVersionExperimentTreatmentResourceSchema()
+	unknownIDs := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue)
+	planVal := appStoreVersionExperimentTreatmentVal(s, "Dark icon", unknownIDs, "en-GB")
+
+	state := createSavedState(t, r, s, planVal, "treatment-id")
+
+	var data AppStoreVersionExperimentTreatmentResourceModel
+	state.Get(context.Background(), &data)
+
+	if len(data.LocalizationIDs.Elements()) != 0 {
+		t.Errorf("expected no localization IDs to be saved, got %v", data.LocalizationIDs)
+	}
+}
+
+func TestAppStoreVersionExperimentTreatmentResource_Update_SyncsLocales(t *testing.T) {
+	client := &mockAppStoreVersionExperimentTreatmentClient{
+		localizations: []experiments.TreatmentLocalization{
+			{ID: "localization-en", TreatmentID: "treatment-id", Locale: "en-GB"},
+			{ID: "localization-de", TreatmentID: "treatment-id", Locale: "de-DE"},
+		},
+	}
+	r := &AppStoreVersionExperimentTreatmentResource{client: client}
+
+	s := appStoreVersionExperimentTreatmentResourceSchema()
+	unknownIDs := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue)
+	planVal := appStoreVersionExperimentTreatmentVal(s, "Dark icon", unknownIDs, "en-GB", "fr-FR")
+
+	req := resource.UpdateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Update(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.deletedIDs) != 1 || client.deletedIDs[0] != "localization-de" {
+		t.Errorf("expected the de-DE localization to be deleted, got %v", client.deletedIDs)
+	}
+
+	var data AppStoreVersionExperimentTreatmentResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	ids := map[string]string{}
+	data.LocalizationIDs.ElementsAs(context.Background(), &ids, false)
+	if len(ids) != 2 || ids["en-GB"] != "localization-en" || ids["fr-FR"] != "localization-1" {
+		t.Errorf("expected en-GB to be kept and fr-FR to be created, got %v", ids)
+	}
+}
+
+func TestAppStoreVersionExperimentTreatmentResource_ModifyPlan_KeepsLocalizationIDs(t *testing.T) {
+	r := AppStoreVersionExperimentTreatmentResource{}
+
+	s := appStoreVersionExperimentTreatmentResourceSchema()
+	ids := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+		"en-GB": tftypes.NewValue(tftypes.String, "localization-en"),
+	})
+	unknownIDs := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue)
+
+	for _, tc := range []struct {
+		name        string
+		locales     []string
+		expectKnown bool
+	}{
+		{name: "renamed", locales: []string{"en-GB"}, expectKnown: true},
+		{name: "locale added", locales: []string{"en-GB", "fr-FR"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			stateVal := appStoreVersionExperimentTreatmentVal(s, "Dark icon", ids, "en-GB")
+			planVal := appStoreVersionExperimentTreatmentVal(s, "Darker icon", unknownIDs, tc.locales...)
+
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: s, Raw: stateVal},
+				Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+			}
+			resp := &resource.ModifyPlanResponse{
+				Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+			}
+
+			r.ModifyPlan(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+			}
+
+			var data AppStoreVersionExperimentTreatmentResourceModel
+			resp.Plan.Get(context.Background(), &data)
+
+			if data.LocalizationIDs.IsUnknown() == tc.expectKnown {
+				t.Errorf("expected known localization IDs=%t, got %v", tc.expectKnown, data.LocalizationIDs)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/testflight"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BetaAppLocalizationResource{}
+var _ resource.ResourceWithImportState = &BetaAppLocalizationResource{}
+
+type betaAppLocalizationClient interface {
+	CreateBetaAppLocalization(ctx context.Context, localization testflight.BetaAppLocalization) (*testflight.BetaAppLocalization, error)
+	GetBetaAppLocalization(ctx context.Context, id string) (*testflight.BetaAppLocalization, error)
+	ModifyBetaAppLocalization(ctx context.Context, id string, localization testflight.BetaAppLocalization) (*testflight.BetaAppLocalization, error)
+	DeleteBetaAppLocalization(ctx context.Context, id string) error
+}
+
+func NewBetaAppLocalizationResource() resource.Resource {
+	return &BetaAppLocalizationResource{}
+}
+
+// BetaAppLocalizationResource defines the resource implementation.
+type BetaAppLocalizationResource struct {
+	client betaAppLocalizationClient
+}
+
+// BetaAppLocalizationResourceModel describes the resource data model.
+type BetaAppLocalizationResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	AppID             types.String `tfsdk:"app_id"`
+	Locale            types.String `tfsdk:"locale"`
+	Description       types.String `tfsdk:"description"`
+	FeedbackEmail     types.String `tfsdk:"feedback_email"`
+	MarketingURL      types.String `tfsdk:"marketing_url"`
+	PrivacyPolicyURL  types.String `tfsdk:"privacy_policy_url"`
+	TVOSPrivacyPolicy types.String `tfsdk:"tvos_privacy_policy"`
+}
+
+func (r *BetaAppLocalizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_beta_app_localization"
+}
+
+func (r *BetaAppLocalizationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage the localized TestFlight information shown to beta testers using the App Store Connect API.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Beta app localization identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the app the localization belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"locale": schema.StringAttribute{
+				MarkdownDescription: "Locale of the localization, e.g. `en-GB`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the app shown to beta testers",
+				Optional:            true,
+			},
+			"feedback_email": schema.StringAttribute{
+				MarkdownDescription: "Email address that receives feedback from beta testers",
+				Optional:            true,
+			},
+			"marketing_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the app's marketing website",
+				Optional:            true,
+			},
+			"privacy_policy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the app's privacy policy",
+				Optional:            true,
+			},
+			"tvos_privacy_policy": schema.StringAttribute{
+				MarkdownDescription: "Privacy policy text shown to tvOS beta testers",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *BetaAppLocalizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(betaAppLocalizationClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected betaAppLocalizationClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BetaAppLocalizationResource) populateState(data *BetaAppLocalizationResourceModel, localization *testflight.BetaAppLocalization) {
+	data.ID = types.StringValue(localization.ID)
+	data.AppID = types.StringValue(localization.AppID)
+	data.Locale = types.StringValue(localization.Locale)
+	data.Description = optionalString(localization.Description)
+	data.FeedbackEmail = optionalString(localization.FeedbackEmail)
+	data.MarketingURL = optionalString(localization.MarketingURL)
+	data.PrivacyPolicyURL = optionalString(localization.PrivacyPolicyURL)
+	data.TVOSPrivacyPolicy = optionalString(localization.TVOSPrivacyPolicy)
+}
+
+func (r *BetaAppLocalizationResource) localization(data *BetaAppLocalizationResourceModel) testflight.BetaAppLocalization {
+	return testflight.BetaAppLocalization{
+		AppID:             data.AppID.ValueString(),
+		Locale:            data.Locale.ValueString(),
+		Description:       data.Description.ValueString(),
+		FeedbackEmail:     data.FeedbackEmail.ValueString(),
+		MarketingURL:      data.MarketingURL.ValueString(),
+		PrivacyPolicyURL:  data.PrivacyPolicyURL.ValueString(),
+		TVOSPrivacyPolicy: data.TVOSPrivacyPolicy.ValueString(),
+	}
+}
+
+func (r *BetaAppLocalizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BetaAppLocalizationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	localization, err := r.client.CreateBetaAppLocalization(ctx, r.localization(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create beta app localization, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a beta app localization")
+
+	r.populateState(&data, localization)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaAppLocalizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BetaAppLocalizationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	localization, err := r.client.GetBetaAppLocalization(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read beta app localization, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, localization)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaAppLocalizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BetaAppLocalizationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	localization, err := r.client.ModifyBetaAppLocalization(ctx, data.ID.ValueString(), r.localization(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify beta app localization, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified a beta app localization")
+
+	r.populateState(&data, localization)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaAppLocalizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BetaAppLocalizationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteBetaAppLocalization(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete beta app localization, got error: %s", err))
+		return
+	}
+}
+
+func (r *BetaAppLocalizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
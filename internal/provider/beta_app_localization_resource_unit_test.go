@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/testflight"
+)
+
+type mockBetaAppLocalizationClient struct {
+	modified *testflight.BetaAppLocalization
+}
+
+func (m *mockBetaAppLocalizationClient) CreateBetaAppLocalization(ctx context.Context, localization testflight.BetaAppLocalization) (*testflight.BetaAppLocalization, error) {
+	localization.ID = "localization-id"
+	return &localization, nil
+}
+
+func (m *mockBetaAppLocalizationClient) GetBetaAppLocalization(ctx context.Context, id string) (*testflight.BetaAppLocalization, error) {
+	return &testflight.BetaAppLocalization{ID: id}, nil
+}
+
+func (m *mockBetaAppLocalizationClient) ModifyBetaAppLocalization(ctx context.Context, id string, localization testflight.BetaAppLocalization) (*testflight.BetaAppLocalization, error) {
+	m.modified = &localization
+	localization.ID = id
+	return &localization, nil
+}
+
+func (m *mockBetaAppLocalizationClient) DeleteBetaAppLocalization(ctx context.Context, id string) error {
+	return nil
+}
+
+func betaAppLocalizationResourceSchema() schema.Schema {
+	r := &BetaAppLocalizationResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func betaAppLocalizationVal(s schema.Schema, id interface{}, feedbackEmail interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                  tftypes.NewValue(tftypes.String, id),
+		"app_id":              tftypes.NewValue(tftypes.String, "1234567890"),
+		"locale":              tftypes.NewValue(tftypes.String, "en-GB"),
+		"description":         tftypes.NewValue(tftypes.String, "Help us test the next release."),
+		"feedback_email":      tftypes.NewValue(tftypes.String, feedbackEmail),
+		"marketing_url":       tftypes.NewValue(tftypes.String, nil),
+		"privacy_policy_url":  tftypes.NewValue(tftypes.String, "https://oliverbinns.co.uk/privacy"),
+		"tvos_privacy_policy": tftypes.NewValue(tftypes.String, nil),
+	})
+}
+
+func TestBetaAppLocalizationResource_Create_SetsStateCorrectly(t *testing.T) {
+	r := &BetaAppLocalizationResource{client: &mockBetaAppLocalizationClient{}}
+
+	s := betaAppLocalizationResourceSchema()
+	planVal := betaAppLocalizationVal(s, nil, "beta@oliverbinns.co.uk")
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+
+	var data BetaAppLocalizationResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "localization-id" {
+		t.Errorf("expected ID 'localization-id', got %q", data.ID.ValueString())
+	}
+	if data.FeedbackEmail.ValueString() != "beta@oliverbinns.co.uk" {
+		t.Errorf("expected FeedbackEmail 'beta@oliverbinns.co.uk', got %q", data.FeedbackEmail.ValueString())
+	}
+	if !data.MarketingURL.IsNull() {
+		t.Errorf("expected MarketingURL to be null, got %q", data.MarketingURL.ValueString())
+	}
+}
+
+func TestBetaAppLocalizationResource_Update_ClearsRemovedValues(t *testing.T) {
+	client := &mockBetaAppLocalizationClient{}
+	r := &BetaAppLocalizationResource{client: client}
+
+	s := betaAppLocalizationResourceSchema()
+	stateVal := betaAppLocalizationVal(s, "localization-id", "beta@oliverbinns.co.uk")
+	planVal := betaAppLocalizationVal(s, "localization-id", nil)
+
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Update(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if client.modified == nil || client.modified.FeedbackEmail != "" {
+		t.Errorf("expected an empty feedback email to be sent")
+	}
+
+	var data BetaAppLocalizationResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if !data.FeedbackEmail.IsNull() {
+		t.Errorf("expected FeedbackEmail to be null, got %q", data.FeedbackEmail.ValueString())
+	}
+}
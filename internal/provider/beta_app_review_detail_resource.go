@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/testflight"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BetaAppReviewDetailResource{}
+var _ resource.ResourceWithImportState = &BetaAppReviewDetailResource{}
+var _ resource.ResourceWithValidateConfig = &BetaAppReviewDetailResource{}
+
+type betaAppReviewDetailClient interface {
+	GetBetaAppReviewDetail(ctx context.Context, appID string) (*testflight.BetaAppReviewDetail, error)
+	ModifyBetaAppReviewDetail(ctx context.Context, id string, detail testflight.BetaAppReviewDetail) (*testflight.BetaAppReviewDetail, error)
+}
+
+func NewBetaAppReviewDetailResource() resource.Resource {
+	return &BetaAppReviewDetailResource{}
+}
+
+// BetaAppReviewDetailResource defines the resource implementation.
+type BetaAppReviewDetailResource struct {
+	client betaAppReviewDetailClient
+}
+
+// BetaAppReviewDetailResourceModel describes the resource data model.
+type BetaAppReviewDetailResourceModel struct {
+	ID                         types.String `tfsdk:"id"`
+	AppID                      types.String `tfsdk:"app_id"`
+	ContactFirstName           types.String `tfsdk:"contact_first_name"`
+	ContactLastName            types.String `tfsdk:"contact_last_name"`
+	ContactPhone               types.String `tfsdk:"contact_phone"`
+	ContactEmail               types.String `tfsdk:"contact_email"`
+	DemoAccountRequired        types.Bool   `tfsdk:"demo_account_required"`
+	DemoAccountName            types.String `tfsdk:"demo_account_name"`
+	DemoAccountPasswordWO      types.String `tfsdk:"demo_account_password_wo"`
+	DemoAccountPasswordVersion types.Int64  `tfsdk:"demo_account_password_wo_version"`
+	Notes                      types.String `tfsdk:"notes"`
+}
+
+func (r *BetaAppReviewDetailResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_beta_app_review_detail"
+}
+
+func (r *BetaAppReviewDetailResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage the contact details and demo account given to Beta App Review for external TestFlight testing using the App Store Connect API.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Beta app review detail identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the app the review details belong to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"contact_first_name": schema.StringAttribute{
+				MarkdownDescription: "Contact's first name",
+				Optional:            true,
+			},
+			"contact_last_name": schema.StringAttribute{
+				MarkdownDescription: "Contact's last name",
+				Optional:            true,
+			},
+			"contact_phone": schema.StringAttribute{
+				MarkdownDescription: "Contact's phone number, including the country code",
+				Optional:            true,
+			},
+			"contact_email": schema.StringAttribute{
+				MarkdownDescription: "Contact's email address",
+				Optional:            true,
+			},
+			"demo_account_required": schema.BoolAttribute{
+				MarkdownDescription: "Whether Beta App Review needs to sign in to review the app",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"demo_account_name": schema.StringAttribute{
+				MarkdownDescription: "User name of the demo account",
+				Optional:            true,
+			},
+			"demo_account_password_wo": schema.StringAttribute{
+				MarkdownDescription: "Password of the demo account. This value is never stored in state; increment `demo_account_password_wo_version` to send a new password.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"demo_account_password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version number for `demo_account_password_wo`. Changing it sends the current password to App Store Connect.",
+				Optional:            true,
+			},
+			"notes": schema.StringAttribute{
+				MarkdownDescription: "Additional information for Beta App Review",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *BetaAppReviewDetailResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(betaAppReviewDetailClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected betaAppReviewDetailClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r BetaAppReviewDetailResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data BetaAppReviewDetailResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.DemoAccountPasswordWO.IsNull() && data.DemoAccountPasswordVersion.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("demo_account_password_wo_version"),
+			"Invalid Configuration",
+			"`demo_account_password_wo_version` must be set when `demo_account_password_wo` is provided.",
+		)
+	}
+}
+
+func (r *BetaAppReviewDetailResource) populateState(data *BetaAppReviewDetailResourceModel, detail *testflight.BetaAppReviewDetail) {
+	data.ID = types.StringValue(detail.ID)
+	data.AppID = types.StringValue(detail.AppID)
+	data.ContactFirstName = optionalString(detail.ContactFirstName)
+	data.ContactLastName = optionalString(detail.ContactLastName)
+	data.ContactPhone = optionalString(detail.ContactPhone)
+	data.ContactEmail = optionalString(detail.ContactEmail)
+	data.DemoAccountRequired = types.BoolValue(detail.DemoAccountRequired)
+	data.DemoAccountName = optionalString(detail.DemoAccountName)
+	data.Notes = optionalString(detail.Notes)
+	data.DemoAccountPasswordWO = types.StringNull()
+}
+
+func (r *BetaAppReviewDetailResource) reviewDetail(data *BetaAppReviewDetailResourceModel, password types.String, sendPassword bool) testflight.BetaAppReviewDetail {
+	detail := testflight.BetaAppReviewDetail{
+		AppID:               data.AppID.ValueString(),
+		ContactFirstName:    data.ContactFirstName.ValueString(),
+		ContactLastName:     data.ContactLastName.ValueString(),
+		ContactPhone:        data.ContactPhone.ValueString(),
+		ContactEmail:        data.ContactEmail.ValueString(),
+		DemoAccountRequired: data.DemoAccountRequired.ValueBool(),
+		DemoAccountName:     data.DemoAccountName.ValueString(),
+		Notes:               data.Notes.ValueString(),
+	}
+	if sendPassword {
+		detail.DemoAccountPassword = password.ValueString()
+	}
+	return detail
+}
+
+func (r *BetaAppReviewDetailResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BetaAppReviewDetailResourceModel
+	var password types.String
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("demo_account_password_wo"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every app has exactly one set of beta review details, so creating the
+	// resource updates the existing ones.
+	existing, err := r.client.GetBetaAppReviewDetail(ctx, data.AppID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read beta app review details, got error: %s", err))
+		return
+	}
+
+	detail, err := r.client.ModifyBetaAppReviewDetail(ctx, existing.ID, r.reviewDetail(&data, password, !password.IsNull()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify beta app review details, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified beta app review details")
+
+	r.populateState(&data, detail)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaAppReviewDetailResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BetaAppReviewDetailResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	detail, err := r.client.GetBetaAppReviewDetail(ctx, data.AppID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read beta app review details, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, detail)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaAppReviewDetailResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BetaAppReviewDetailResourceModel
+	var state BetaAppReviewDetailResourceModel
+	var password types.String
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("demo_account_password_wo"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sendPassword := !data.DemoAccountPasswordVersion.Equal(state.DemoAccountPasswordVersion)
+
+	detail, err := r.client.ModifyBetaAppReviewDetail(ctx, data.ID.ValueString(), r.reviewDetail(&data, password, sendPassword))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify beta app review details, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified beta app review details")
+
+	r.populateState(&data, detail)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaAppReviewDetailResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Beta app review details cannot be deleted, so they are only removed from state.
+	tflog.Trace(ctx, "removed beta app review details from state")
+}
+
+func (r *BetaAppReviewDetailResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("app_id"), req, resp)
+}
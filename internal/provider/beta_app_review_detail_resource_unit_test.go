@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/testflight"
+)
+
+type mockBetaAppReviewDetailClient struct {
+	modifiedID string
+	modified   *testflight.BetaAppReviewDetail
+}
+
+func (m *mockBetaAppReviewDetailClient) GetBetaAppReviewDetail(ctx context.Context, appID string) (*testflight.BetaAppReviewDetail, error) {
+	return &testflight.BetaAppReviewDetail{ID: "detail-id", AppID: appID}, nil
+}
+
+func (m *mockBetaAppReviewDetailClient) ModifyBetaAppReviewDetail(ctx context.Context, id string, detail testflight.BetaAppReviewDetail) (*testflight.BetaAppReviewDetail, error) {
+	m.modifiedID = id
+	m.modified = &detail
+	detail.ID = id
+	return &detail, nil
+}
+
+func betaAppReviewDetailResourceSchema() schema.Schema {
+	r := &BetaAppReviewDetailResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func betaAppReviewDetailVal(s schema.Schema, id interface{}, password interface{}, passwordVersion interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                               tftypes.NewValue(tftypes.String, id),
+		"app_id":                           tftypes.NewValue(tftypes.String, "1234567890"),
+		"contact_first_name":               tftypes.NewValue(tftypes.String, "Oliver"),
+		"contact_last_name":                tftypes.NewValue(tftypes.String, "Binns"),
+		"contact_phone":                    tftypes.NewValue(tftypes.String, nil),
+		"contact_email":                    tftypes.NewValue(tftypes.String, "mail@oliverbinns.co.uk"),
+		"demo_account_required":            tftypes.NewValue(tftypes.Bool, true),
+		"demo_account_name":                tftypes.NewValue(tftypes.String, "reviewer@example.com"),
+		"demo_account_password_wo":         tftypes.NewValue(tftypes.String, password),
+		"demo_account_password_wo_version": tftypes.NewValue(tftypes.Number, passwordVersion),
+		"notes":                            tftypes.NewValue(tftypes.String, nil),
+	})
+}
+
+func TestBetaAppReviewDetailResource_Create_ModifiesExistingDetails(t *testing.T) {
+	client := &mockBetaAppReviewDetailClient{}
+	r := &BetaAppReviewDetailResource{client: client}
+
+	s := betaAppReviewDetailResourceSchema()
+	configVal := betaAppReviewDetailVal(s, nil, "hunter2", 1)
+	planVal := betaAppReviewDetailVal(s, nil, nil, 1)
+
+	req := resource.CreateRequest{
+		Config: tfsdk.Config{Schema: s, Raw: configVal},
+		Plan:   tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if client.modifiedID != "detail-id" {
+		t.Errorf("expected the app's existing details to be modified, got %q", client.modifiedID)
+	}
+	if client.modified.DemoAccountPassword != "hunter2" {
+		t.Errorf("expected the demo account password to be sent")
+	}
+
+	var data BetaAppReviewDetailResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if !data.DemoAccountPasswordWO.IsNull() {
+		t.Errorf("expected the demo account password not to be stored in state")
+	}
+}
+
+func TestBetaAppReviewDetailResource_Update_KeepsPasswordWhenVersionUnchanged(t *testing.T) {
+	client := &mockBetaAppReviewDetailClient{}
+	r := &BetaAppReviewDetailResource{client: client}
+
+	s := betaAppReviewDetailResourceSchema()
+	stateVal := betaAppReviewDetailVal(s, "detail-id", nil, 1)
+	configVal := betaAppReviewDetailVal(s, "detail-id", "hunter2", 1)
+	planVal := betaAppReviewDetailVal(s, "detail-id", nil, 1)
+
+	req := resource.UpdateRequest{
+		Config: tfsdk.Config{Schema: s, Raw: configVal},
+		Plan:   tfsdk.Plan{Schema: s, Raw: planVal},
+		State:  tfsdk.State{Schema: s, Raw: stateVal},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Update(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if client.modified.DemoAccountPassword != "" {
+		t.Errorf("expected the password not to be sent, got %q", client.modified.DemoAccountPassword)
+	}
+}
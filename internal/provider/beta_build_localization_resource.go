@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/testflight"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BetaBuildLocalizationResource{}
+var _ resource.ResourceWithImportState = &BetaBuildLocalizationResource{}
+
+type betaBuildLocalizationClient interface {
+	FindBetaBuildLocalization(ctx context.Context, buildID string, locale string) (*testflight.BetaBuildLocalization, error)
+	CreateBetaBuildLocalization(ctx context.Context, localization testflight.BetaBuildLocalization) (*testflight.BetaBuildLocalization, error)
+	GetBetaBuildLocalization(ctx context.Context, id string) (*testflight.BetaBuildLocalization, error)
+	ModifyBetaBuildLocalization(ctx context.Context, id string, localization testflight.BetaBuildLocalization) (*testflight.BetaBuildLocalization, error)
+	DeleteBetaBuildLocalization(ctx context.Context, id string) error
+}
+
+func NewBetaBuildLocalizationResource() resource.Resource {
+	return &BetaBuildLocalizationResource{}
+}
+
+// BetaBuildLocalizationResource defines the resource implementation.
+type BetaBuildLocalizationResource struct {
+	client betaBuildLocalizationClient
+}
+
+// BetaBuildLocalizationResourceModel describes the resource data model.
+type BetaBuildLocalizationResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	BuildID  types.String `tfsdk:"build_id"`
+	Locale   types.String `tfsdk:"locale"`
+	WhatsNew types.String `tfsdk:"whats_new"`
+}
+
+func (r *BetaBuildLocalizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_beta_build_localization"
+}
+
+func (r *BetaBuildLocalizationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the localized \"What to Test\" notes shown to TestFlight testers for a build.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the build localization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"build_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the build the notes belong to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"locale": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The locale of the notes, e.g. `en-GB`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"whats_new": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The \"What to Test\" text shown to testers.",
+			},
+		},
+	}
+}
+
+func (r *BetaBuildLocalizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(betaBuildLocalizationClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected betaBuildLocalizationClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BetaBuildLocalizationResource) populateState(data *BetaBuildLocalizationResourceModel, localization *testflight.BetaBuildLocalization) {
+	data.ID = types.StringValue(localization.ID)
+	data.BuildID = types.StringValue(localization.BuildID)
+	data.Locale = types.StringValue(localization.Locale)
+	data.WhatsNew = types.StringValue(localization.WhatsNew)
+}
+
+func (r *BetaBuildLocalizationResource) localization(data *BetaBuildLocalizationResourceModel) testflight.BetaBuildLocalization {
+	return testflight.BetaBuildLocalization{
+		BuildID:  data.BuildID.ValueString(),
+		Locale:   data.Locale.ValueString(),
+		WhatsNew: data.WhatsNew.ValueString(),
+	}
+}
+
+func (r *BetaBuildLocalizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BetaBuildLocalizationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := r.client.FindBetaBuildLocalization(ctx, data.BuildID.ValueString(), data.Locale.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read beta build localization, got error: %s", err))
+		return
+	}
+
+	// Notes uploaded alongside the build already have a localization, so adopt it.
+	var localization *testflight.BetaBuildLocalization
+	if existing != nil {
+		localization, err = r.client.ModifyBetaBuildLocalization(ctx, existing.ID, r.localization(&data))
+	} else {
+		localization, err = r.client.CreateBetaBuildLocalization(ctx, r.localization(&data))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create beta build localization, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a beta build localization")
+
+	r.populateState(&data, localization)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaBuildLocalizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BetaBuildLocalizationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	localization, err := r.client.GetBetaBuildLocalization(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read beta build localization, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, localization)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaBuildLocalizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BetaBuildLocalizationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	localization, err := r.client.ModifyBetaBuildLocalization(ctx, data.ID.ValueString(), r.localization(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify beta build localization, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified a beta build localization")
+
+	r.populateState(&data, localization)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaBuildLocalizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BetaBuildLocalizationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteBetaBuildLocalization(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete beta build localization, got error: %s", err))
+		return
+	}
+}
+
+func (r *BetaBuildLocalizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/testflight"
+)
+
+type mockBetaBuildLocalizationClient struct {
+	existing   *testflight.BetaBuildLocalization
+	created    bool
+	modifiedID string
+}
+
+func (m *mockBetaBuildLocalizationClient) FindBetaBuildLocalization(ctx context.Context, buildID string, locale string) (*testflight.BetaBuildLocalization, error) {
+	return m.existing, nil
+}
+
+func (m *mockBetaBuildLocalizationClient) CreateBetaBuildLocalization(ctx context.Context, localization testflight.BetaBuildLocalization) (*testflight.BetaBuildLocalization, error) {
+	m.created = true
+	localization.ID = "new-id"
+	return &localization, nil
+}
+
+func (m *mockBetaBuildLocalizationClient) GetBetaBuildLocalization(ctx context.Context, id string) (*testflight.BetaBuildLocalization, error) {
+	return &testflight.BetaBuildLocalization{ID: id}, nil
+}
+
+func (m *mockBetaBuildLocalizationClient) ModifyBetaBuildLocalization(ctx context.Context, id string, localization testflight.BetaBuildLocalization) (*testflight.BetaBuildLocalization, error) {
+	m.modifiedID = id
+	localization.ID = id
+	return &localization, nil
+}
+
+func (m *mockBetaBuildLocalizationClient) DeleteBetaBuildLocalization(ctx context.Context, id string) error {
+	return nil
+}
+
+func betaBuildLocalizationResourceSchema() schema.Schema {
+	r := &BetaBuildLocalizationResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func betaBuildLocalizationVal(s schema.Schema) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":        tftypes.NewValue(tftypes.String, nil),
+		"build_id":  tftypes.NewValue(tftypes.String, "build-id"),
+		"locale":    tftypes.NewValue(tftypes.String, "en-GB"),
+		"whats_new": tftypes.NewValue(tftypes.String, "Try the new onboarding flow."),
+	})
+}
+
+func TestBetaBuildLocalizationResource_Create(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		existing   *testflight.BetaBuildLocalization
+		expectedID string
+	}{
+		{name: "creates new localization", expectedID: "new-id"},
+		{name: "adopts existing localization", existing: &testflight.BetaBuildLocalization{ID: "existing-id"}, expectedID: "existing-id"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &mockBetaBuildLocalizationClient{existing: tc.existing}
+			r := &BetaBuildLocalizationResource{client: client}
+
+			s := betaBuildLocalizationResourceSchema()
+			planVal := betaBuildLocalizationVal(s)
+
+			req := resource.CreateRequest{
+				Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+			}
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{Schema: s, Raw: planVal},
+			}
+
+			r.Create(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+			}
+			if client.created == (tc.existing != nil) {
+				t.Errorf("expected create=%t, got %t", tc.existing == nil, client.created)
+			}
+
+			var data BetaBuildLocalizationResourceModel
+			resp.State.Get(context.Background(), &data)
+
+			if data.ID.ValueString() != tc.expectedID {
+				t.Errorf("expected ID %q, got %q", tc.expectedID, data.ID.ValueString())
+			}
+			if data.WhatsNew.ValueString() != "Try the new onboarding flow." {
+				t.Errorf("expected WhatsNew to be set, got %q", data.WhatsNew.ValueString())
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BetaGroupBuildResource{}
+var _ resource.ResourceWithImportState = &BetaGroupBuildResource{}
+
+type betaGroupBuildClient interface {
+	ListBetaGroupBuildIDs(ctx context.Context, groupID string) ([]string, error)
+	AddBuildsToBetaGroup(ctx context.Context, groupID string, buildIDs []string) error
+	RemoveBuildsFromBetaGroup(ctx context.Context, groupID string, buildIDs []string) error
+}
+
+func NewBetaGroupBuildResource() resource.Resource {
+	return &BetaGroupBuildResource{}
+}
+
+// BetaGroupBuildResource defines the resource implementation.
+type BetaGroupBuildResource struct {
+	client betaGroupBuildClient
+}
+
+// BetaGroupBuildResourceModel describes the resource data model.
+type BetaGroupBuildResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	BetaGroupID types.String `tfsdk:"beta_group_id"`
+	BuildID     types.String `tfsdk:"build_id"`
+}
+
+func (r *BetaGroupBuildResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_beta_group_build"
+}
+
+func (r *BetaGroupBuildResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Makes a build available to the testers in a TestFlight beta group. " +
+			"Use the `appstoreconnect_build` data source to select a build by version or build number.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The beta group and build identifiers separated by a slash.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"beta_group_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the beta group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"build_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the build to add to the group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BetaGroupBuildResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(betaGroupBuildClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected betaGroupBuildClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BetaGroupBuildResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BetaGroupBuildResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.AddBuildsToBetaGroup(ctx, data.BetaGroupID.ValueString(), []string{data.BuildID.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add build to beta group, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "added a build to a beta group")
+
+	data.ID = types.StringValue(data.BetaGroupID.ValueString() + "/" + data.BuildID.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaGroupBuildResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BetaGroupBuildResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	buildIDs, err := r.client.ListBetaGroupBuildIDs(ctx, data.BetaGroupID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read beta group builds, got error: %s", err))
+		return
+	}
+	if !slices.Contains(buildIDs, data.BuildID.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(data.BetaGroupID.ValueString() + "/" + data.BuildID.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaGroupBuildResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute requires replacement, so there is nothing to update.
+	var data BetaGroupBuildResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaGroupBuildResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BetaGroupBuildResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveBuildsFromBetaGroup(ctx, data.BetaGroupID.ValueString(), []string{data.BuildID.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove build from beta group, got error: %s", err))
+		return
+	}
+}
+
+func (r *BetaGroupBuildResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupID, buildID, ok := strings.Cut(req.ID, "/")
+	if !ok || groupID == "" || buildID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("%q is not a valid import ID. Provide the beta group ID and build ID separated by a slash.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("beta_group_id"), groupID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("build_id"), buildID)...)
+}
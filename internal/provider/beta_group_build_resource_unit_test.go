@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type mockBetaGroupBuildClient struct {
+	buildIDs []string
+}
+
+func (m *mockBetaGroupBuildClient) ListBetaGroupBuildIDs(ctx context.Context, groupID string) ([]string, error) {
+	return m.buildIDs, nil
+}
+
+func (m *mockBetaGroupBuildClient) AddBuildsToBetaGroup(ctx context.Context, groupID string, buildIDs []string) error {
+	m.buildIDs = append(m.buildIDs, buildIDs...)
+	return nil
+}
+
+func (m *mockBetaGroupBuildClient) RemoveBuildsFromBetaGroup(ctx context.Context, groupID string, buildIDs []string) error {
+	m.buildIDs = setDifference(m.buildIDs, buildIDs)
+	return nil
+}
+
+func betaGroupBuildResourceSchema() schema.Schema {
+	r := &BetaGroupBuildResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func betaGroupBuildVal(s schema.Schema, id interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":            tftypes.NewValue(tftypes.String, id),
+		"beta_group_id": tftypes.NewValue(tftypes.String, "group-id"),
+		"build_id":      tftypes.NewValue(tftypes.String, "build-id"),
+	})
+}
+
+func TestBetaGroupBuildResource_Create_AddsBuild(t *testing.T) {
+	client := &mockBetaGroupBuildClient{}
+	r := &BetaGroupBuildResource{client: client}
+
+	s := betaGroupBuildResourceSchema()
+	planVal := betaGroupBuildVal(s, nil)
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.buildIDs) != 1 || client.buildIDs[0] != "build-id" {
+		t.Errorf("expected build to be added to the group, got %v", client.buildIDs)
+	}
+
+	var data BetaGroupBuildResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "group-id/build-id" {
+		t.Errorf("expected ID 'group-id/build-id', got %q", data.ID.ValueString())
+	}
+}
+
+func TestBetaGroupBuildResource_Read_RemovesMissingBuild(t *testing.T) {
+	r := &BetaGroupBuildResource{client: &mockBetaGroupBuildClient{}}
+
+	s := betaGroupBuildResourceSchema()
+	stateVal := betaGroupBuildVal(s, "group-id/build-id")
+
+	req := resource.ReadRequest{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+	resp := &resource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("expected the resource to be removed from state")
+	}
+}
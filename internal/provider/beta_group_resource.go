@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/testflight"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BetaGroupResource{}
+var _ resource.ResourceWithImportState = &BetaGroupResource{}
+var _ resource.ResourceWithValidateConfig = &BetaGroupResource{}
+
+// betaGroupPublicLinkMaxLimit is the largest number of testers Apple allows to join through a public link.
+const betaGroupPublicLinkMaxLimit = 10000
+
+type betaGroupClient interface {
+	CreateBetaGroup(ctx context.Context, group testflight.BetaGroup) (*testflight.BetaGroup, error)
+	GetBetaGroup(ctx context.Context, id string) (*testflight.BetaGroup, error)
+	ModifyBetaGroup(ctx context.Context, id string, group testflight.BetaGroup) (*testflight.BetaGroup, error)
+	DeleteBetaGroup(ctx context.Context, id string) error
+}
+
+func NewBetaGroupResource() resource.Resource {
+	return &BetaGroupResource{}
+}
+
+// BetaGroupResource defines the resource implementation.
+type BetaGroupResource struct {
+	client betaGroupClient
+}
+
+// BetaGroupResourceModel describes the resource data model.
+type BetaGroupResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	AppID             types.String `tfsdk:"app_id"`
+	Name              types.String `tfsdk:"name"`
+	Internal          types.Bool   `tfsdk:"internal"`
+	PublicLinkEnabled types.Bool   `tfsdk:"public_link_enabled"`
+	PublicLinkLimit   types.Int64  `tfsdk:"public_link_limit"`
+	FeedbackEnabled   types.Bool   `tfsdk:"feedback_enabled"`
+	PublicLink        types.String `tfsdk:"public_link"`
+}
+
+func (r *BetaGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_beta_group"
+}
+
+func (r *BetaGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a TestFlight beta group for an app.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the beta group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the app the beta group tests.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the beta group.",
+			},
+			"internal": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the group is for internal testers (members of the App Store Connect team) rather than external testers.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"public_link_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether external testers can join the group through a public link.",
+			},
+			"public_link_limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum number of testers that can join through the public link, up to 10,000. When omitted, no limit is applied.",
+			},
+			"feedback_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether testers in the group can send feedback through TestFlight.",
+			},
+			"public_link": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The public link testers can use to join the group, when enabled.",
+			},
+		},
+	}
+}
+
+func (r *BetaGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(betaGroupClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected betaGroupClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r BetaGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data BetaGroupResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Public links are only available to external groups
+	if data.Internal.ValueBool() && data.PublicLinkEnabled.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("public_link_enabled"),
+			"Invalid Configuration",
+			"Public links can only be enabled for external groups. Set `internal` to false or remove `public_link_enabled`.",
+		)
+		return
+	}
+
+	if data.PublicLinkLimit.IsNull() || data.PublicLinkLimit.IsUnknown() {
+		return
+	}
+
+	if !data.PublicLinkEnabled.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("public_link_limit"),
+			"Invalid Configuration",
+			"`public_link_limit` can only be set when `public_link_enabled` is true.",
+		)
+		return
+	}
+
+	if limit := data.PublicLinkLimit.ValueInt64(); limit < 1 || limit > betaGroupPublicLinkMaxLimit {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("public_link_limit"),
+			"Invalid Configuration",
+			fmt.Sprintf("`public_link_limit` must be between 1 and %d, got %d.", betaGroupPublicLinkMaxLimit, limit),
+		)
+	}
+}
+
+func (r *BetaGroupResource) populateState(data *BetaGroupResourceModel, group *testflight.BetaGroup) {
+	data.ID = types.StringValue(group.ID)
+	data.AppID = types.StringValue(group.AppID)
+	data.Name = types.StringValue(group.Name)
+	data.Internal = types.BoolValue(group.IsInternalGroup)
+	data.PublicLinkEnabled = types.BoolValue(group.PublicLinkEnabled)
+	data.FeedbackEnabled = types.BoolValue(group.FeedbackEnabled)
+	data.PublicLink = optionalString(group.PublicLink)
+
+	if group.PublicLinkLimitEnabled {
+		data.PublicLinkLimit = types.Int64Value(group.PublicLinkLimit)
+	} else {
+		data.PublicLinkLimit = types.Int64Null()
+	}
+}
+
+func (r *BetaGroupResource) betaGroup(data *BetaGroupResourceModel) testflight.BetaGroup {
+	return testflight.BetaGroup{
+		AppID:                  data.AppID.ValueString(),
+		Name:                   data.Name.ValueString(),
+		IsInternalGroup:        data.Internal.ValueBool(),
+		PublicLinkEnabled:      data.PublicLinkEnabled.ValueBool(),
+		PublicLinkLimitEnabled: !data.PublicLinkLimit.IsNull(),
+		PublicLinkLimit:        data.PublicLinkLimit.ValueInt64(),
+		FeedbackEnabled:        data.FeedbackEnabled.ValueBool(),
+	}
+}
+
+func (r *BetaGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BetaGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.CreateBetaGroup(ctx, r.betaGroup(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create beta group, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a beta group")
+
+	r.populateState(&data, group)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BetaGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.GetBetaGroup(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read beta group, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, group)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BetaGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.ModifyBetaGroup(ctx, data.ID.ValueString(), r.betaGroup(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify beta group, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified a beta group")
+
+	r.populateState(&data, group)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BetaGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteBetaGroup(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete beta group, got error: %s", err))
+		return
+	}
+}
+
+func (r *BetaGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
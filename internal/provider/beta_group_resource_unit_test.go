@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/testflight"
+)
+
+type mockBetaGroupClient struct {
+	createFn func(ctx context.Context, group testflight.BetaGroup) (*testflight.BetaGroup, error)
+}
+
+func (m *mockBetaGroupClient) CreateBetaGroup(ctx context.Context, group testflight.BetaGroup) (*testflight.BetaGroup, error) {
+	if m.createFn != nil {
+		return m.createFn(ctx, group)
+	}
+	return &testflight.BetaGroup{}, nil
+}
+
+func (m *mockBetaGroupClient) GetBetaGroup(ctx context.Context, id string) (*testflight.BetaGroup, error) {
+	return &testflight.BetaGroup{ID: id}, nil
+}
+
+func (m *mockBetaGroupClient) ModifyBetaGroup(ctx context.Context, id string, group testflight.BetaGroup) (*testflight.BetaGroup, error) {
+	group.ID = id
+	return &group, nil
+}
+
+func (m *mockBetaGroupClient) DeleteBetaGroup(ctx context.Context, id string) error {
+	return nil
+}
+
+func betaGroupResourceSchema() schema.Schema {
+	r := &BetaGroupResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func betaGroupVal(s schema.Schema, internal bool, publicLinkEnabled bool, publicLinkLimit interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                  tftypes.NewValue(tftypes.String, nil),
+		"app_id":              tftypes.NewValue(tftypes.String, "1234567890"),
+		"name":                tftypes.NewValue(tftypes.String, "External Partners"),
+		"internal":            tftypes.NewValue(tftypes.Bool, internal),
+		"public_link_enabled": tftypes.NewValue(tftypes.Bool, publicLinkEnabled),
+		"public_link_limit":   tftypes.NewValue(tftypes.Number, publicLinkLimit),
+		"feedback_enabled":    tftypes.NewValue(tftypes.Bool, true),
+		"public_link":         tftypes.NewValue(tftypes.String, nil),
+	})
+}
+
+func TestBetaGroupResource_Create_SetsStateCorrectly(t *testing.T) {
+	var captured testflight.BetaGroup
+
+	r := &BetaGroupResource{
+		client: &mockBetaGroupClient{
+			createFn: func(ctx context.Context, group testflight.BetaGroup) (*testflight.BetaGroup, error) {
+				captured = group
+				group.ID = "group-id"
+				group.PublicLink = "https://testflight.apple.com/join/abcd1234"
+				return &group, nil
+			},
+		},
+	}
+
+	s := betaGroupResourceSchema()
+	planVal := betaGroupVal(s, false, true, 500)
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if !captured.PublicLinkLimitEnabled || captured.PublicLinkLimit != 500 {
+		t.Errorf("expected public link limit of 500 to be enabled, got enabled=%t limit=%d", captured.PublicLinkLimitEnabled, captured.PublicLinkLimit)
+	}
+
+	var data BetaGroupResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "group-id" {
+		t.Errorf("expected ID 'group-id', got %q", data.ID.ValueString())
+	}
+	if data.PublicLink.ValueString() != "https://testflight.apple.com/join/abcd1234" {
+		t.Errorf("expected PublicLink to be set, got %q", data.PublicLink.ValueString())
+	}
+	if data.PublicLinkLimit.ValueInt64() != 500 {
+		t.Errorf("expected PublicLinkLimit 500, got %d", data.PublicLinkLimit.ValueInt64())
+	}
+}
+
+func TestBetaGroupResource_ValidateConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name              string
+		internal          bool
+		publicLinkEnabled bool
+		publicLinkLimit   interface{}
+		expectError       bool
+	}{
+		{name: "external group with limit", publicLinkEnabled: true, publicLinkLimit: 500},
+		{name: "internal group with public link", internal: true, publicLinkEnabled: true, expectError: true},
+		{name: "limit without public link", publicLinkLimit: 500, expectError: true},
+		{name: "limit above maximum", publicLinkEnabled: true, publicLinkLimit: 10001, expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := BetaGroupResource{}
+
+			s := betaGroupResourceSchema()
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: betaGroupVal(s, tc.internal, tc.publicLinkEnabled, tc.publicLinkLimit)},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error=%t, got diagnostics %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
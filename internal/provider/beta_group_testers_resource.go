@@ -0,0 +1,355 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/testflight"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BetaGroupTestersResource{}
+var _ resource.ResourceWithImportState = &BetaGroupTestersResource{}
+var _ resource.ResourceWithValidateConfig = &BetaGroupTestersResource{}
+
+// betaGroupTestersBatchSize is the number of testers sent in each relationship request.
+const betaGroupTestersBatchSize = 100
+
+type betaGroupTestersClient interface {
+	ListBetaGroupTesters(ctx context.Context, groupID string) ([]testflight.BetaTester, error)
+	FindBetaTestersByEmail(ctx context.Context, emails []string) ([]testflight.BetaTester, error)
+	CreateBetaTester(ctx context.Context, tester testflight.BetaTester) (*testflight.BetaTester, error)
+	AddBetaTestersToGroup(ctx context.Context, groupID string, testerIDs []string) error
+	RemoveBetaTestersFromGroup(ctx context.Context, groupID string, testerIDs []string) error
+}
+
+func NewBetaGroupTestersResource() resource.Resource {
+	return &BetaGroupTestersResource{}
+}
+
+// BetaGroupTestersResource defines the resource implementation.
+type BetaGroupTestersResource struct {
+	client betaGroupTestersClient
+}
+
+// BetaGroupTestersResourceModel describes the resource data model.
+type BetaGroupTestersResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	BetaGroupID types.String `tfsdk:"beta_group_id"`
+	Emails      types.Set    `tfsdk:"emails"`
+}
+
+func (r *BetaGroupTestersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_beta_group_testers"
+}
+
+func (r *BetaGroupTestersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manages the complete list of testers in a TestFlight beta group. " +
+			"Testers not listed are removed from the group, so this resource should not be combined with " +
+			"`appstoreconnect_beta_tester` resources for the same group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the beta group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"beta_group_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the beta group whose testers are managed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"emails": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The email addresses of every tester in the group. Testers that don't exist yet are invited.",
+			},
+		},
+	}
+}
+
+func (r *BetaGroupTestersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(betaGroupTestersClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected betaGroupTestersClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r BetaGroupTestersResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data BetaGroupTestersResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Emails.IsNull() || data.Emails.IsUnknown() {
+		return
+	}
+
+	var emails []types.String
+	resp.Diagnostics.Append(data.Emails.ElementsAs(ctx, &emails, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apple matches email addresses case-insensitively, so two that only
+	// differ by case would be the same tester.
+	seen := map[string]string{}
+	for _, email := range emails {
+		if email.IsUnknown() || email.IsNull() {
+			continue
+		}
+		key := strings.ToLower(email.ValueString())
+		if other, ok := seen[key]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("emails"),
+				"Invalid Configuration",
+				fmt.Sprintf("`emails` must not contain the same address twice, got %q and %q.", other, email.ValueString()),
+			)
+			continue
+		}
+		seen[key] = email.ValueString()
+	}
+}
+
+// populateState records the group's testers, keeping the configured spelling
+// of any email address that only differs from Apple's by case.
+func (r *BetaGroupTestersResource) populateState(ctx context.Context, data *BetaGroupTestersResourceModel, testers []testflight.BetaTester) diag.Diagnostics {
+	configured := []string{}
+	if !data.Emails.IsNull() && !data.Emails.IsUnknown() {
+		if diags := data.Emails.ElementsAs(ctx, &configured, false); diags.HasError() {
+			return diags
+		}
+	}
+
+	emails := []string{}
+	for _, tester := range testers {
+		email := tester.Email
+		if i := slices.IndexFunc(configured, func(c string) bool { return strings.EqualFold(c, email) }); i >= 0 {
+			email = configured[i]
+		}
+		emails = append(emails, email)
+	}
+
+	data.ID = data.BetaGroupID
+
+	var diags diag.Diagnostics
+	data.Emails, diags = types.SetValueFrom(ctx, types.StringType, emails)
+	return diags
+}
+
+// reconcile adds and removes testers so the group contains exactly the planned emails.
+func (r *BetaGroupTestersResource) reconcile(ctx context.Context, groupID string, planned []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current, err := r.client.ListBetaGroupTesters(ctx, groupID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list beta group testers, got error: %s", err))
+		return diags
+	}
+
+	currentIDs := map[string]string{}
+	for _, tester := range current {
+		currentIDs[strings.ToLower(tester.Email)] = tester.ID
+	}
+
+	plannedEmails := map[string]string{}
+	for _, email := range planned {
+		plannedEmails[strings.ToLower(email)] = email
+	}
+
+	removed := []string{}
+	for email, id := range currentIDs {
+		if _, ok := plannedEmails[email]; !ok {
+			removed = append(removed, id)
+		}
+	}
+
+	added := []string{}
+	for email, original := range plannedEmails {
+		if _, ok := currentIDs[email]; !ok {
+			added = append(added, original)
+		}
+	}
+	slices.Sort(added)
+
+	for batch := range slices.Chunk(removed, betaGroupTestersBatchSize) {
+		if err := r.client.RemoveBetaTestersFromGroup(ctx, groupID, batch); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to remove testers from beta group, got error: %s", err))
+			return diags
+		}
+	}
+	if len(removed) > 0 {
+		tflog.Trace(ctx, "removed testers from a beta group", map[string]interface{}{"count": len(removed)})
+	}
+
+	if len(added) == 0 {
+		return diags
+	}
+
+	// Testers who already belong to other groups can be added by ID in bulk,
+	// but new testers have to be invited one at a time.
+	existingIDs := []string{}
+	existingEmails := map[string]bool{}
+	for batch := range slices.Chunk(added, betaGroupTestersBatchSize) {
+		testers, err := r.client.FindBetaTestersByEmail(ctx, batch)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to find beta testers, got error: %s", err))
+			return diags
+		}
+		for _, tester := range testers {
+			existingIDs = append(existingIDs, tester.ID)
+			existingEmails[strings.ToLower(tester.Email)] = true
+		}
+	}
+
+	for batch := range slices.Chunk(existingIDs, betaGroupTestersBatchSize) {
+		if err := r.client.AddBetaTestersToGroup(ctx, groupID, batch); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to add testers to beta group, got error: %s", err))
+			return diags
+		}
+	}
+
+	invited := 0
+	for _, email := range added {
+		if existingEmails[strings.ToLower(email)] {
+			continue
+		}
+
+		_, err := r.client.CreateBetaTester(ctx, testflight.BetaTester{
+			Email:        email,
+			BetaGroupIDs: []string{groupID},
+		})
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to invite beta tester %q, got error: %s", email, err))
+			return diags
+		}
+		invited++
+	}
+
+	tflog.Trace(ctx, "added testers to a beta group", map[string]interface{}{
+		"existing": len(existingIDs),
+		"invited":  invited,
+	})
+
+	return diags
+}
+
+func (r *BetaGroupTestersResource) apply(ctx context.Context, data *BetaGroupTestersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	planned := []string{}
+	diags.Append(data.Emails.ElementsAs(ctx, &planned, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(r.reconcile(ctx, data.BetaGroupID.ValueString(), planned)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	testers, err := r.client.ListBetaGroupTesters(ctx, data.BetaGroupID.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list beta group testers, got error: %s", err))
+		return diags
+	}
+
+	diags.Append(r.populateState(ctx, data, testers)...)
+	return diags
+}
+
+func (r *BetaGroupTestersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BetaGroupTestersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaGroupTestersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BetaGroupTestersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	testers, err := r.client.ListBetaGroupTesters(ctx, data.BetaGroupID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list beta group testers, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, testers)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaGroupTestersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BetaGroupTestersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaGroupTestersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BetaGroupTestersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, data.BetaGroupID.ValueString(), []string{})...)
+}
+
+func (r *BetaGroupTestersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("beta_group_id"), req.ID)...)
+}
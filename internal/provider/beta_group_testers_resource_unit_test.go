@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/testflight"
+)
+
+type mockBetaGroupTestersClient struct {
+	members  []testflight.BetaTester
+	account  []testflight.BetaTester
+	added    [][]string
+	removed  [][]string
+	invited  []string
+	nextID   int
+	findCall int
+}
+
+func (m *mockBetaGroupTestersClient) ListBetaGroupTesters(ctx context.Context, groupID string) ([]testflight.BetaTester, error) {
+	return m.members, nil
+}
+
+func (m *mockBetaGroupTestersClient) FindBetaTestersByEmail(ctx context.Context, emails []string) ([]testflight.BetaTester, error) {
+	m.findCall++
+	found := []testflight.BetaTester{}
+	for _, tester := range m.account {
+		for _, email := range emails {
+			if strings.EqualFold(tester.Email, email) {
+				found = append(found, tester)
+			}
+		}
+	}
+	return found, nil
+}
+
+func (m *mockBetaGroupTestersClient) CreateBetaTester(ctx context.Context, tester testflight.BetaTester) (*testflight.BetaTester, error) {
+	m.nextID++
+	tester.ID = fmt.Sprintf("new-%d", m.nextID)
+	m.invited = append(m.invited, tester.Email)
+	m.members = append(m.members, tester)
+	return &tester, nil
+}
+
+func (m *mockBetaGroupTestersClient) AddBetaTestersToGroup(ctx context.Context, groupID string, testerIDs []string) error {
+	m.added = append(m.added, testerIDs)
+	for _, tester := range m.account {
+		for _, id := range testerIDs {
+			if tester.ID == id {
+				m.members = append(m.members, tester)
+			}
+		}
+	}
+	return nil
+}
+
+func (m *mockBetaGroupTestersClient) RemoveBetaTestersFromGroup(ctx context.Context, groupID string, testerIDs []string) error {
+	m.removed = append(m.removed, testerIDs)
+	remaining := []testflight.BetaTester{}
+	for _, tester := range m.members {
+		keep := true
+		for _, id := range testerIDs {
+			if tester.ID == id {
+				keep = false
+			}
+		}
+		if keep {
+			remaining = append(remaining, tester)
+		}
+	}
+	m.members = remaining
+	return nil
+}
+
+func betaGroupTestersResourceSchema() schema.Schema {
+	r := &BetaGroupTestersResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func betaGroupTestersVal(s schema.Schema, id interface{}, emails ...string) tftypes.Value {
+	values := []tftypes.Value{}
+	for _, email := range emails {
+		values = append(values, tftypes.NewValue(tftypes.String, email))
+	}
+
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":            tftypes.NewValue(tftypes.String, id),
+		"beta_group_id": tftypes.NewValue(tftypes.String, "group-id"),
+		"emails":        tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, values),
+	})
+}
+
+func TestBetaGroupTestersResource_Update_OnlyChangesDifference(t *testing.T) {
+	client := &mockBetaGroupTestersClient{
+		members: []testflight.BetaTester{
+			{ID: "a", Email: "a@example.com"},
+			{ID: "b", Email: "b@example.com"},
+		},
+		account: []testflight.BetaTester{
+			{ID: "c", Email: "c@example.com"},
+		},
+	}
+	r := &BetaGroupTestersResource{client: client}
+
+	s := betaGroupTestersResourceSchema()
+	stateVal := betaGroupTestersVal(s, "group-id", "a@example.com", "b@example.com")
+	planVal := betaGroupTestersVal(s, "group-id", "B@example.com", "c@example.com", "d@example.com")
+
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Update(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if !reflect.DeepEqual(client.removed, [][]string{{"a"}}) {
+		t.Errorf("expected only tester 'a' to be removed, got %v", client.removed)
+	}
+	if !reflect.DeepEqual(client.added, [][]string{{"c"}}) {
+		t.Errorf("expected existing tester 'c' to be added by ID, got %v", client.added)
+	}
+	if !reflect.DeepEqual(client.invited, []string{"d@example.com"}) {
+		t.Errorf("expected only 'd@example.com' to be invited, got %v", client.invited)
+	}
+
+	var data BetaGroupTestersResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	emails := []string{}
+	data.Emails.ElementsAs(context.Background(), &emails, false)
+	sort.Strings(emails)
+	if !reflect.DeepEqual(emails, []string{"B@example.com", "c@example.com", "d@example.com"}) {
+		t.Errorf("expected configured emails in state, got %v", emails)
+	}
+}
+
+func TestBetaGroupTestersResource_Create_BatchesRequests(t *testing.T) {
+	client := &mockBetaGroupTestersClient{}
+	emails := []string{}
+	for i := 0; i < betaGroupTestersBatchSize+1; i++ {
+		email := fmt.Sprintf("tester%d@example.com", i)
+		emails = append(emails, email)
+		client.account = append(client.account, testflight.BetaTester{ID: fmt.Sprintf("%d", i), Email: email})
+	}
+	r := &BetaGroupTestersResource{client: client}
+
+	s := betaGroupTestersResourceSchema()
+	planVal := betaGroupTestersVal(s, nil, emails...)
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if client.findCall != 2 {
+		t.Errorf("expected 2 lookup requests, got %d", client.findCall)
+	}
+	if len(client.added) != 2 || len(client.added[0]) != betaGroupTestersBatchSize || len(client.added[1]) != 1 {
+		t.Errorf("expected testers to be added in batches of %d, got %d batches", betaGroupTestersBatchSize, len(client.added))
+	}
+	if len(client.invited) != 0 {
+		t.Errorf("expected no invitations for existing testers, got %v", client.invited)
+	}
+}
+
+func TestBetaGroupTestersResource_ValidateConfig(t *testing.T) {
+	tests := map[string]struct {
+		emails      []string
+		expectError bool
+	}{
+		"distinct":          {emails: []string{"a@example.com", "b@example.com"}},
+		"differ by case":    {emails: []string{"a@example.com", "A@example.com"}, expectError: true},
+		"single mixed case": {emails: []string{"A@example.com"}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := BetaGroupTestersResource{}
+
+			s := betaGroupTestersResourceSchema()
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: betaGroupTestersVal(s, nil, tt.emails...)},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Errorf("expected error: %v, got diagnostics: %v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
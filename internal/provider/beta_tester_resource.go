@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/testflight"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BetaTesterResource{}
+var _ resource.ResourceWithImportState = &BetaTesterResource{}
+
+type betaTesterClient interface {
+	CreateBetaTester(ctx context.Context, tester testflight.BetaTester) (*testflight.BetaTester, error)
+	GetBetaTester(ctx context.Context, id string) (*testflight.BetaTester, error)
+	FindBetaTesterByEmail(ctx context.Context, email string) (*testflight.BetaTester, error)
+	AddBetaTesterToGroups(ctx context.Context, id string, groupIDs []string) error
+	RemoveBetaTesterFromGroups(ctx context.Context, id string, groupIDs []string) error
+	DeleteBetaTester(ctx context.Context, id string) error
+}
+
+func NewBetaTesterResource() resource.Resource {
+	return &BetaTesterResource{}
+}
+
+// BetaTesterResource defines the resource implementation.
+type BetaTesterResource struct {
+	client betaTesterClient
+}
+
+// BetaTesterResourceModel describes the resource data model.
+type BetaTesterResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	FirstName    types.String `tfsdk:"first_name"`
+	LastName     types.String `tfsdk:"last_name"`
+	Email        types.String `tfsdk:"email"`
+	BetaGroupIDs types.Set    `tfsdk:"beta_group_ids"`
+	State        types.String `tfsdk:"state"`
+}
+
+func (r *BetaTesterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_beta_tester"
+}
+
+func (r *BetaTesterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Invite a TestFlight beta tester and manage the beta groups they belong to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Beta tester identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"first_name": schema.StringAttribute{
+				MarkdownDescription: "Beta tester's first name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"last_name": schema.StringAttribute{
+				MarkdownDescription: "Beta tester's last name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Beta tester's email address",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"beta_group_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the beta groups the tester belongs to",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "State of the tester's invitation, e.g. `INVITED` or `ACCEPTED`",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *BetaTesterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(betaTesterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected betaTesterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BetaTesterResource) populateState(ctx context.Context, data *BetaTesterResourceModel, tester *testflight.BetaTester) diag.Diagnostics {
+	data.ID = types.StringValue(tester.ID)
+	data.FirstName = types.StringValue(tester.FirstName)
+	data.LastName = types.StringValue(tester.LastName)
+	data.Email = types.StringValue(tester.Email)
+	data.State = optionalString(tester.State)
+
+	var diags diag.Diagnostics
+	data.BetaGroupIDs, diags = types.SetValueFrom(ctx, types.StringType, tester.BetaGroupIDs)
+	return diags
+}
+
+func (r *BetaTesterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BetaTesterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupIDs := []string{}
+	resp.Diagnostics.Append(data.BetaGroupIDs.ElementsAs(ctx, &groupIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tester, err := r.client.CreateBetaTester(ctx, testflight.BetaTester{
+		FirstName:    data.FirstName.ValueString(),
+		LastName:     data.LastName.ValueString(),
+		Email:        data.Email.ValueString(),
+		BetaGroupIDs: groupIDs,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to invite beta tester, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "invited a beta tester")
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, tester)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaTesterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BetaTesterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tester, err := r.client.GetBetaTester(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read beta tester, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, tester)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaTesterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BetaTesterResourceModel
+	var state BetaTesterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := []string{}
+	resp.Diagnostics.Append(data.BetaGroupIDs.ElementsAs(ctx, &planned, false)...)
+	current := []string{}
+	resp.Diagnostics.Append(state.BetaGroupIDs.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only touch the groups that changed, so the tester keeps access to builds
+	// in the groups they remain a member of.
+	if added := setDifference(planned, current); len(added) > 0 {
+		err := r.client.AddBetaTesterToGroups(ctx, data.ID.ValueString(), added)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add beta tester to groups, got error: %s", err))
+			return
+		}
+		tflog.Trace(ctx, "added beta tester to groups", map[string]interface{}{"groups": added})
+	}
+
+	if removed := setDifference(current, planned); len(removed) > 0 {
+		err := r.client.RemoveBetaTesterFromGroups(ctx, data.ID.ValueString(), removed)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove beta tester from groups, got error: %s", err))
+			return
+		}
+		tflog.Trace(ctx, "removed beta tester from groups", map[string]interface{}{"groups": removed})
+	}
+
+	tester, err := r.client.GetBetaTester(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read beta tester, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, tester)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BetaTesterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BetaTesterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteBetaTester(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete beta tester, got error: %s", err))
+		return
+	}
+}
+
+func (r *BetaTesterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, err := uuid.Parse(req.ID); err == nil {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	if !strings.Contains(req.ID, "@") {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("%q is not a valid import ID. Provide either the beta tester's UUID or email address.", req.ID),
+		)
+		return
+	}
+
+	tester, err := r.client.FindBetaTesterByEmail(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find beta tester, got error: %s", err))
+		return
+	}
+
+	if tester == nil {
+		resp.Diagnostics.AddError(
+			"Beta tester not found",
+			fmt.Sprintf("No TestFlight beta tester with email %q was found.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), tester.ID)...)
+}
+
+// setDifference returns the values in a that are not in b, preserving the order of a.
+func setDifference(a []string, b []string) []string {
+	difference := []string{}
+	for _, value := range a {
+		if !slices.Contains(b, value) {
+			difference = append(difference, value)
+		}
+	}
+	return difference
+}
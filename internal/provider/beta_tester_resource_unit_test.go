@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/testflight"
+)
+
+type mockBetaTesterClient struct {
+	tester            testflight.BetaTester
+	addedGroupIDs     []string
+	removedGroupIDs   []string
+	findByEmailResult *testflight.BetaTester
+}
+
+func (m *mockBetaTesterClient) CreateBetaTester(ctx context.Context, tester testflight.BetaTester) (*testflight.BetaTester, error) {
+	m.tester = tester
+	m.tester.ID = "tester-id"
+	m.tester.State = "INVITED"
+	return &m.tester, nil
+}
+
+func (m *mockBetaTesterClient) GetBetaTester(ctx context.Context, id string) (*testflight.BetaTester, error) {
+	return &m.tester, nil
+}
+
+func (m *mockBetaTesterClient) FindBetaTesterByEmail(ctx context.Context, email string) (*testflight.BetaTester, error) {
+	return m.findByEmailResult, nil
+}
+
+func (m *mockBetaTesterClient) AddBetaTesterToGroups(ctx context.Context, id string, groupIDs []string) error {
+	m.addedGroupIDs = append(m.addedGroupIDs, groupIDs...)
+	m.tester.BetaGroupIDs = append(m.tester.BetaGroupIDs, groupIDs...)
+	return nil
+}
+
+func (m *mockBetaTesterClient) RemoveBetaTesterFromGroups(ctx context.Context, id string, groupIDs []string) error {
+	m.removedGroupIDs = append(m.removedGroupIDs, groupIDs...)
+	m.tester.BetaGroupIDs = setDifference(m.tester.BetaGroupIDs, groupIDs)
+	return nil
+}
+
+func (m *mockBetaTesterClient) DeleteBetaTester(ctx context.Context, id string) error {
+	return nil
+}
+
+func betaTesterResourceSchema() schema.Schema {
+	r := &BetaTesterResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func betaTesterVal(s schema.Schema, id interface{}, groupIDs ...string) tftypes.Value {
+	groups := []tftypes.Value{}
+	for _, groupID := range groupIDs {
+		groups = append(groups, tftypes.NewValue(tftypes.String, groupID))
+	}
+
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":             tftypes.NewValue(tftypes.String, id),
+		"first_name":     tftypes.NewValue(tftypes.String, "Oliver"),
+		"last_name":      tftypes.NewValue(tftypes.String, "Binns"),
+		"email":          tftypes.NewValue(tftypes.String, "mail@oliverbinns.co.uk"),
+		"beta_group_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, groups),
+		"state":          tftypes.NewValue(tftypes.String, nil),
+	})
+}
+
+func TestBetaTesterResource_Create_InvitesToGroups(t *testing.T) {
+	client := &mockBetaTesterClient{}
+	r := &BetaTesterResource{client: client}
+
+	s := betaTesterResourceSchema()
+	planVal := betaTesterVal(s, nil, "group-a", "group-b")
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+
+	groupIDs := append([]string{}, client.tester.BetaGroupIDs...)
+	sort.Strings(groupIDs)
+	if !reflect.DeepEqual(groupIDs, []string{"group-a", "group-b"}) {
+		t.Errorf("expected tester to be invited to both groups, got %v", groupIDs)
+	}
+
+	var data BetaTesterResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "tester-id" {
+		t.Errorf("expected ID 'tester-id', got %q", data.ID.ValueString())
+	}
+	if data.State.ValueString() != "INVITED" {
+		t.Errorf("expected State 'INVITED', got %q", data.State.ValueString())
+	}
+}
+
+func TestBetaTesterResource_Update_ReconcilesGroupsAsSetDiff(t *testing.T) {
+	client := &mockBetaTesterClient{
+		tester: testflight.BetaTester{
+			ID:           "tester-id",
+			FirstName:    "Oliver",
+			LastName:     "Binns",
+			Email:        "mail@oliverbinns.co.uk",
+			BetaGroupIDs: []string{"group-a", "group-b"},
+		},
+	}
+	r := &BetaTesterResource{client: client}
+
+	s := betaTesterResourceSchema()
+	stateVal := betaTesterVal(s, "tester-id", "group-a", "group-b")
+	planVal := betaTesterVal(s, "tester-id", "group-b", "group-c")
+
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Update(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if !reflect.DeepEqual(client.addedGroupIDs, []string{"group-c"}) {
+		t.Errorf("expected only 'group-c' to be added, got %v", client.addedGroupIDs)
+	}
+	if !reflect.DeepEqual(client.removedGroupIDs, []string{"group-a"}) {
+		t.Errorf("expected only 'group-a' to be removed, got %v", client.removedGroupIDs)
+	}
+}
+
+func TestBetaTesterResource_ImportState_ByEmail(t *testing.T) {
+	r := &BetaTesterResource{
+		client: &mockBetaTesterClient{
+			findByEmailResult: &testflight.BetaTester{ID: "tester-id"},
+		},
+	}
+
+	s := betaTesterResourceSchema()
+	req := resource.ImportStateRequest{ID: "mail@oliverbinns.co.uk"}
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)},
+	}
+
+	r.ImportState(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+
+	var data BetaTesterResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "tester-id" {
+		t.Errorf("expected ID 'tester-id', got %q", data.ID.ValueString())
+	}
+}
+
+func TestBetaTesterResource_ImportState_NotFound(t *testing.T) {
+	r := &BetaTesterResource{client: &mockBetaTesterClient{}}
+
+	s := betaTesterResourceSchema()
+	req := resource.ImportStateRequest{ID: "nobody@example.com"}
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)},
+	}
+
+	r.ImportState(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when no tester has the email address")
+	}
+}
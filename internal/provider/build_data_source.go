@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/oliver-binns/appstore-go/builds"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BuildDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &BuildDataSource{}
+
+// buildPlatforms are the platforms Apple accepts when filtering builds.
+var buildPlatforms = []string{"IOS", "MAC_OS", "TV_OS", "VISION_OS"}
+
+type buildClient interface {
+	FindLatestBuild(ctx context.Context, filter builds.Filter) (*builds.Build, error)
+}
+
+func NewBuildDataSource() datasource.DataSource {
+	return &BuildDataSource{}
+}
+
+// BuildDataSource defines the data source implementation.
+type BuildDataSource struct {
+	client buildClient
+}
+
+// BuildDataSourceModel describes the data source data model.
+type BuildDataSourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	AppID                   types.String `tfsdk:"app_id"`
+	Platform                types.String `tfsdk:"platform"`
+	Version                 types.String `tfsdk:"version"`
+	BuildNumber             types.String `tfsdk:"build_number"`
+	ProcessingState         types.String `tfsdk:"processing_state"`
+	UploadedDate            types.String `tfsdk:"uploaded_date"`
+	ExpirationDate          types.String `tfsdk:"expiration_date"`
+	Expired                 types.Bool   `tfsdk:"expired"`
+	MinOSVersion            types.String `tfsdk:"min_os_version"`
+	UsesNonExemptEncryption types.Bool   `tfsdk:"uses_non_exempt_encryption"`
+}
+
+func (d *BuildDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_build"
+}
+
+func (d *BuildDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a build of an app. Without a `build_number`, the most recently uploaded build " +
+			"that has finished processing is returned; narrow the search with `platform` and `version`. " +
+			"With a `build_number`, the matching build is returned whatever its processing state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the build.",
+			},
+			"app_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the app the build belongs to.",
+			},
+			"platform": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The platform of the build: `IOS`, `MAC_OS`, `TV_OS` or `VISION_OS`.",
+			},
+			"version": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The app version the build was uploaded for, e.g. `1.2.0`.",
+			},
+			"build_number": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The build number, e.g. `42`.",
+			},
+			"processing_state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The processing state of the build.",
+			},
+			"uploaded_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time the build was uploaded.",
+			},
+			"expiration_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time the build expires in TestFlight.",
+			},
+			"expired": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the build has expired and can no longer be tested.",
+			},
+			"min_os_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The minimum operating system version the build supports.",
+			},
+			"uses_non_exempt_encryption": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the build uses non-exempt encryption. Null until export compliance has been answered.",
+			},
+		},
+	}
+}
+
+func (d *BuildDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(buildClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected buildClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d BuildDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data BuildDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Platform.IsNull() || data.Platform.IsUnknown() {
+		return
+	}
+
+	if !slices.Contains(buildPlatforms, data.Platform.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("platform"),
+			"Invalid Platform",
+			fmt.Sprintf("`platform` must be one of %s, got %q.", strings.Join(buildPlatforms, ", "), data.Platform.ValueString()),
+		)
+	}
+}
+
+func (d *BuildDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BuildDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := builds.Filter{
+		AppID:       data.AppID.ValueString(),
+		Platform:    data.Platform.ValueString(),
+		AppVersion:  data.Version.ValueString(),
+		BuildNumber: data.BuildNumber.ValueString(),
+	}
+
+	// A specific build is returned while it is still processing, so pipelines
+	// can wait on it, but "latest" only considers builds that are ready to test.
+	if data.BuildNumber.IsNull() {
+		filter.ProcessingState = "VALID"
+	}
+
+	build, err := d.client.FindLatestBuild(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find build, got error: %s", err))
+		return
+	}
+
+	if build == nil {
+		resp.Diagnostics.AddError(
+			"Build not found",
+			fmt.Sprintf("No build matching the given filters was found for app %q.", data.AppID.ValueString()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(build.ID)
+	data.Version = types.StringValue(build.AppVersion)
+	data.BuildNumber = types.StringValue(build.Version)
+	data.ProcessingState = types.StringValue(build.ProcessingState)
+	data.UploadedDate = optionalString(build.UploadedDate)
+	data.ExpirationDate = optionalString(build.ExpirationDate)
+	data.Expired = types.BoolValue(build.Expired)
+	data.MinOSVersion = optionalString(build.MinOSVersion)
+	data.UsesNonExemptEncryption = types.BoolPointerValue(build.UsesNonExemptEncryption)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
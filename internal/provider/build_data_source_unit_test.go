@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/builds"
+)
+
+type mockBuildClient struct {
+	findLatestFn func(ctx context.Context, filter builds.Filter) (*builds.Build, error)
+}
+
+func (m *mockBuildClient) FindLatestBuild(ctx context.Context, filter builds.Filter) (*builds.Build, error) {
+	if m.findLatestFn != nil {
+		return m.findLatestFn(ctx, filter)
+	}
+	return nil, nil
+}
+
+func buildDataSourceSchema() schema.Schema {
+	d := &BuildDataSource{}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func buildVal(s schema.Schema, platform interface{}, version interface{}, buildNumber interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                         tftypes.NewValue(tftypes.String, nil),
+		"app_id":                     tftypes.NewValue(tftypes.String, "1234567890"),
+		"platform":                   tftypes.NewValue(tftypes.String, platform),
+		"version":                    tftypes.NewValue(tftypes.String, version),
+		"build_number":               tftypes.NewValue(tftypes.String, buildNumber),
+		"processing_state":           tftypes.NewValue(tftypes.String, nil),
+		"uploaded_date":              tftypes.NewValue(tftypes.String, nil),
+		"expiration_date":            tftypes.NewValue(tftypes.String, nil),
+		"expired":                    tftypes.NewValue(tftypes.Bool, nil),
+		"min_os_version":             tftypes.NewValue(tftypes.String, nil),
+		"uses_non_exempt_encryption": tftypes.NewValue(tftypes.Bool, nil),
+	})
+}
+
+func TestBuildDataSource_Read_FindsLatestProcessedBuild(t *testing.T) {
+	var captured builds.Filter
+
+	d := &BuildDataSource{
+		client: &mockBuildClient{
+			findLatestFn: func(ctx context.Context, filter builds.Filter) (*builds.Build, error) {
+				captured = filter
+				return &builds.Build{
+					ID:              "build-id",
+					AppVersion:      "1.2.0",
+					Version:         "42",
+					ProcessingState: "VALID",
+					UploadedDate:    "2026-10-01T12:00:00Z",
+				}, nil
+			},
+		},
+	}
+
+	s := buildDataSourceSchema()
+	configVal := buildVal(s, "IOS", nil, nil)
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: s, Raw: configVal},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: configVal},
+	}
+
+	d.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if captured.Platform != "IOS" || captured.ProcessingState != "VALID" {
+		t.Errorf("expected a filter for processed iOS builds, got %+v", captured)
+	}
+
+	var data BuildDataSourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "build-id" {
+		t.Errorf("expected ID 'build-id', got %q", data.ID.ValueString())
+	}
+	if data.Version.ValueString() != "1.2.0" || data.BuildNumber.ValueString() != "42" {
+		t.Errorf("expected version 1.2.0 (42), got %s (%s)", data.Version.ValueString(), data.BuildNumber.ValueString())
+	}
+}
+
+func TestBuildDataSource_Read_NotFound(t *testing.T) {
+	d := &BuildDataSource{client: &mockBuildClient{}}
+
+	s := buildDataSourceSchema()
+	configVal := buildVal(s, nil, "9.9.9", nil)
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: s, Raw: configVal},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: configVal},
+	}
+
+	d.Read(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when no build matches")
+	}
+}
+
+func TestBuildDataSource_ValidateConfig_RejectsUnknownPlatform(t *testing.T) {
+	d := BuildDataSource{}
+
+	s := buildDataSourceSchema()
+	req := datasource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: s, Raw: buildVal(s, "ANDROID", nil, nil)},
+	}
+	resp := &datasource.ValidateConfigResponse{}
+
+	d.ValidateConfig(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for an unsupported platform")
+	}
+}
+
+func TestBuildDataSource_Read_ByBuildNumberIncludesProcessingBuilds(t *testing.T) {
+	var captured builds.Filter
+	usesEncryption := false
+
+	d := &BuildDataSource{
+		client: &mockBuildClient{
+			findLatestFn: func(ctx context.Context, filter builds.Filter) (*builds.Build, error) {
+				captured = filter
+				return &builds.Build{
+					ID:                      "build-id",
+					AppVersion:              "1.2.0",
+					Version:                 "42",
+					ProcessingState:         "PROCESSING",
+					MinOSVersion:            "17.0",
+					UsesNonExemptEncryption: &usesEncryption,
+				}, nil
+			},
+		},
+	}
+
+	s := buildDataSourceSchema()
+	configVal := buildVal(s, "IOS", nil, "42")
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: s, Raw: configVal},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: configVal},
+	}
+
+	d.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if captured.BuildNumber != "42" || captured.ProcessingState != "" {
+		t.Errorf("expected a filter for build 42 in any state, got %+v", captured)
+	}
+
+	var data BuildDataSourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ProcessingState.ValueString() != "PROCESSING" {
+		t.Errorf("expected ProcessingState 'PROCESSING', got %q", data.ProcessingState.ValueString())
+	}
+	if data.MinOSVersion.ValueString() != "17.0" {
+		t.Errorf("expected MinOSVersion '17.0', got %q", data.MinOSVersion.ValueString())
+	}
+	if data.UsesNonExemptEncryption.IsNull() || data.UsesNonExemptEncryption.ValueBool() {
+		t.Errorf("expected UsesNonExemptEncryption to be false, got %s", data.UsesNonExemptEncryption)
+	}
+}
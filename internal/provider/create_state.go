@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// saveCreated saves created, the state of an object Apple has just created,
+// before Create goes on to make further changes to it such as adding
+// localizations. If one of those changes fails the object is still tracked,
+// rather than left in App Store Connect without Terraform knowing about it.
+//
+// Terraform taints an object whose Create fails and replaces it on the next
+// apply. created only describes what Apple has confirmed, so after
+// `terraform untaint` the next apply makes the remaining changes in place
+// instead; this matters for objects whose identifiers Apple never allows to
+// be reused, such as product IDs.
+func saveCreated(ctx context.Context, state *tfsdk.State, created any) diag.Diagnostics {
+	return state.Set(ctx, created)
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/iap"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &InAppPurchasePriceScheduleResource{}
+var _ resource.ResourceWithImportState = &InAppPurchasePriceScheduleResource{}
+var _ resource.ResourceWithValidateConfig = &InAppPurchasePriceScheduleResource{}
+
+type inAppPurchasePriceScheduleClient interface {
+	GetInAppPurchasePriceSchedule(ctx context.Context, purchaseID string) (*iap.PriceSchedule, error)
+	SetInAppPurchasePriceSchedule(ctx context.Context, schedule iap.PriceSchedule) (*iap.PriceSchedule, error)
+}
+
+func NewInAppPurchasePriceScheduleResource() resource.Resource {
+	return &InAppPurchasePriceScheduleResource{}
+}
+
+// InAppPurchasePriceScheduleResource defines the resource implementation.
+type InAppPurchasePriceScheduleResource struct {
+	client inAppPurchasePriceScheduleClient
+}
+
+// InAppPurchasePriceScheduleResourceModel describes the resource data model.
+type InAppPurchasePriceScheduleResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	InAppPurchaseID types.String `tfsdk:"in_app_purchase_id"`
+	BaseTerritory   types.String `tfsdk:"base_territory"`
+	Prices          types.Set    `tfsdk:"price"`
+}
+
+func (r *InAppPurchasePriceScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_in_app_purchase_price_schedule"
+}
+
+func (r *InAppPurchasePriceScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the prices of an in-app purchase. App Store Connect equalizes the base territory price " +
+			"across every other territory, unless a territory has its own price. Price schedules can't be deleted, " +
+			"so destroying this resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the in-app purchase.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"in_app_purchase_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the in-app purchase to price.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"base_territory": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The three-letter code of the territory other prices are equalized from, e.g. `USA`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"price": priceBlock("in-app purchase"),
+		},
+	}
+}
+
+func (r *InAppPurchasePriceScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(inAppPurchasePriceScheduleClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected inAppPurchasePriceScheduleClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r InAppPurchasePriceScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data InAppPurchasePriceScheduleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatePrices(ctx, data.BaseTerritory, data.Prices)...)
+}
+
+func (r *InAppPurchasePriceScheduleResource) populateState(ctx context.Context, data *InAppPurchasePriceScheduleResourceModel, schedule *iap.PriceSchedule) diag.Diagnostics {
+	data.ID = types.StringValue(schedule.InAppPurchaseID)
+	data.InAppPurchaseID = types.StringValue(schedule.InAppPurchaseID)
+	data.BaseTerritory = types.StringValue(schedule.BaseTerritory)
+
+	prices := []scheduledPrice{}
+	for _, price := range schedule.Prices {
+		prices = append(prices, scheduledPrice(price))
+	}
+
+	var diags diag.Diagnostics
+	data.Prices, diags = pricesValue(ctx, prices)
+	return diags
+}
+
+// setSchedule replaces the price schedule with the one in data, then records
+// the result in data.
+func (r *InAppPurchasePriceScheduleResource) setSchedule(ctx context.Context, data *InAppPurchasePriceScheduleResourceModel) diag.Diagnostics {
+	prices, diags := scheduledPricesFrom(ctx, data.Prices)
+	if diags.HasError() {
+		return diags
+	}
+
+	schedule := iap.PriceSchedule{
+		InAppPurchaseID: data.InAppPurchaseID.ValueString(),
+		BaseTerritory:   data.BaseTerritory.ValueString(),
+	}
+	for _, price := range prices {
+		schedule.Prices = append(schedule.Prices, iap.Price(price))
+	}
+
+	updated, err := r.client.SetInAppPurchasePriceSchedule(ctx, schedule)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to set in-app purchase price schedule, got error: %s", err))
+		return diags
+	}
+
+	diags.Append(r.populateState(ctx, data, updated)...)
+	return diags
+}
+
+func (r *InAppPurchasePriceScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data InAppPurchasePriceScheduleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setSchedule(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "set an in-app purchase price schedule")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InAppPurchasePriceScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InAppPurchasePriceScheduleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := r.client.GetInAppPurchasePriceSchedule(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read in-app purchase price schedule, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, schedule)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InAppPurchasePriceScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data InAppPurchasePriceScheduleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setSchedule(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated an in-app purchase price schedule")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InAppPurchasePriceScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Price schedules cannot be deleted, so they are only removed from state.
+	tflog.Trace(ctx, "removed in-app purchase price schedule from state")
+}
+
+func (r *InAppPurchasePriceScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/iap"
+)
+
+type mockInAppPurchasePriceScheduleClient struct {
+	set *iap.PriceSchedule
+}
+
+func (m *mockInAppPurchasePriceScheduleClient) GetInAppPurchasePriceSchedule(ctx context.Context, purchaseID string) (*iap.PriceSchedule, error) {
+	return m.set, nil
+}
+
+func (m *mockInAppPurchasePriceScheduleClient) SetInAppPurchasePriceSchedule(ctx context.Context, schedule iap.PriceSchedule) (*iap.PriceSchedule, error) {
+	m.set = &schedule
+	return &schedule, nil
+}
+
+func inAppPurchasePriceScheduleResourceSchema() schema.Schema {
+	r := &InAppPurchasePriceScheduleResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func priceVal(territory string, pricePointID string, startDate interface{}) tftypes.Value {
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"territory":      tftypes.String,
+		"price_point_id": tftypes.String,
+		"start_date":     tftypes.String,
+	}}, map[string]tftypes.Value{
+		"territory":      tftypes.NewValue(tftypes.String, territory),
+		"price_point_id": tftypes.NewValue(tftypes.String, pricePointID),
+		"start_date":     tftypes.NewValue(tftypes.String, startDate),
+	})
+}
+
+func pricesVal(prices ...tftypes.Value) tftypes.Value {
+	return tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"territory":      tftypes.String,
+		"price_point_id": tftypes.String,
+		"start_date":     tftypes.String,
+	}}}, prices)
+}
+
+func inAppPurchasePriceScheduleVal(s schema.Schema, id interface{}, prices tftypes.Value) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                 tftypes.NewValue(tftypes.String, id),
+		"in_app_purchase_id": tftypes.NewValue(tftypes.String, "iap-id"),
+		"base_territory":     tftypes.NewValue(tftypes.String, "USA"),
+		"price":              prices,
+	})
+}
+
+func TestInAppPurchasePriceScheduleResource_Create_SetsSchedule(t *testing.T) {
+	client := &mockInAppPurchasePriceScheduleClient{}
+	r := &InAppPurchasePriceScheduleResource{client: client}
+
+	s := inAppPurchasePriceScheduleResourceSchema()
+	planVal := inAppPurchasePriceScheduleVal(s, nil, pricesVal(
+		priceVal("USA", "usa-099", nil),
+		priceVal("USA", "usa-129", "2026-01-01"),
+		priceVal("GBR", "gbr-079", nil),
+	))
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if client.set.BaseTerritory != "USA" {
+		t.Errorf("expected base territory USA, got %q", client.set.BaseTerritory)
+	}
+	if len(client.set.Prices) != 3 {
+		t.Errorf("expected 3 prices to be set, got %d", len(client.set.Prices))
+	}
+
+	var data InAppPurchasePriceScheduleResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "iap-id" {
+		t.Errorf("expected ID 'iap-id', got %q", data.ID.ValueString())
+	}
+	if len(data.Prices.Elements()) != 3 {
+		t.Errorf("expected 3 prices in state, got %d", len(data.Prices.Elements()))
+	}
+}
+
+func TestInAppPurchasePriceScheduleResource_ValidateConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		prices      tftypes.Value
+		expectError bool
+	}{
+		{name: "valid", prices: pricesVal(
+			priceVal("USA", "usa-099", nil),
+			priceVal("USA", "usa-129", "2026-01-01"),
+		)},
+		{name: "no current base price", prices: pricesVal(
+			priceVal("USA", "usa-129", "2026-01-01"),
+			priceVal("GBR", "gbr-079", nil),
+		), expectError: true},
+		{name: "malformed start date", prices: pricesVal(
+			priceVal("USA", "usa-099", nil),
+			priceVal("USA", "usa-129", "01/01/2026"),
+		), expectError: true},
+		{name: "two prices on the same date", prices: pricesVal(
+			priceVal("USA", "usa-099", nil),
+			priceVal("USA", "usa-129", nil),
+		), expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := InAppPurchasePriceScheduleResource{}
+
+			s := inAppPurchasePriceScheduleResourceSchema()
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: inAppPurchasePriceScheduleVal(s, nil, tc.prices)},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error=%t, got diagnostics %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,385 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/iap"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &InAppPurchaseResource{}
+var _ resource.ResourceWithImportState = &InAppPurchaseResource{}
+var _ resource.ResourceWithValidateConfig = &InAppPurchaseResource{}
+var _ resource.ResourceWithModifyPlan = &InAppPurchaseResource{}
+
+// inAppPurchaseTypes are the kinds of in-app purchase that aren't auto-renewable subscriptions.
+var inAppPurchaseTypes = []string{"CONSUMABLE", "NON_CONSUMABLE", "NON_RENEWING_SUBSCRIPTION"}
+
+type inAppPurchaseClient interface {
+	CreateInAppPurchase(ctx context.Context, purchase iap.InAppPurchase) (*iap.InAppPurchase, error)
+	GetInAppPurchase(ctx context.Context, id string) (*iap.InAppPurchase, error)
+	ModifyInAppPurchase(ctx context.Context, id string, purchase iap.InAppPurchase) (*iap.InAppPurchase, error)
+	DeleteInAppPurchase(ctx context.Context, id string) error
+	ListInAppPurchaseLocalizations(ctx context.Context, purchaseID string) ([]iap.Localization, error)
+	CreateInAppPurchaseLocalization(ctx context.Context, localization iap.Localization) (*iap.Localization, error)
+	ModifyInAppPurchaseLocalization(ctx context.Context, id string, localization iap.Localization) (*iap.Localization, error)
+	DeleteInAppPurchaseLocalization(ctx context.Context, id string) error
+}
+
+func NewInAppPurchaseResource() resource.Resource {
+	return &InAppPurchaseResource{}
+}
+
+// InAppPurchaseResource defines the resource implementation.
+type InAppPurchaseResource struct {
+	client inAppPurchaseClient
+}
+
+// InAppPurchaseResourceModel describes the resource data model.
+type InAppPurchaseResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	AppID          types.String `tfsdk:"app_id"`
+	ProductID      types.String `tfsdk:"product_id"`
+	ReferenceName  types.String `tfsdk:"reference_name"`
+	Type           types.String `tfsdk:"type"`
+	FamilySharable types.Bool   `tfsdk:"family_sharable"`
+	ReviewNote     types.String `tfsdk:"review_note"`
+	State          types.String `tfsdk:"state"`
+	Localizations  types.Set    `tfsdk:"localization"`
+}
+
+func (r *InAppPurchaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_in_app_purchase"
+}
+
+func (r *InAppPurchaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a consumable, non-consumable or non-renewing subscription in-app purchase. " +
+			"If its localizations can't be added, the in-app purchase is created but tainted; as product IDs can never be reused, " +
+			"run `terraform untaint` before applying again so the localizations are added without replacing it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the in-app purchase.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the app the in-app purchase belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"product_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The product identifier used by StoreKit, e.g. `com.example.app.coins.100`. Product IDs can never be reused.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reference_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name used for the in-app purchase in App Store Connect and sales reports.",
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The type of in-app purchase: `CONSUMABLE`, `NON_CONSUMABLE` or `NON_RENEWING_SUBSCRIPTION`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"family_sharable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the purchase can be shared with Family Sharing. Once enabled, it cannot be turned off.",
+			},
+			"review_note": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Notes for App Review about the in-app purchase.",
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The review state of the in-app purchase, e.g. `MISSING_METADATA` or `APPROVED`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"localization": productLocalizationBlock("in-app purchase"),
+		},
+	}
+}
+
+func (r *InAppPurchaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(inAppPurchaseClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected inAppPurchaseClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r InAppPurchaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data InAppPurchaseResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Type.IsNull() && !data.Type.IsUnknown() && !slices.Contains(inAppPurchaseTypes, data.Type.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Configuration",
+			fmt.Sprintf("`type` must be one of %s, got %q. Use `appstoreconnect_subscription` for auto-renewable subscriptions.", strings.Join(inAppPurchaseTypes, ", "), data.Type.ValueString()),
+		)
+	}
+
+	resp.Diagnostics.Append(validateProductLocalizations(ctx, data.Localizations)...)
+}
+
+func (r InAppPurchaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(validateFamilySharableChange(ctx, req, "an in-app purchase")...)
+}
+
+// validateFamilySharableChange rejects plans that turn off Family Sharing,
+// which App Store Connect doesn't allow once it has been enabled for a product.
+func validateFamilySharableChange(ctx context.Context, req resource.ModifyPlanRequest, product string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Nothing to check when the resource is being created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return diags
+	}
+
+	var state, plan types.Bool
+	diags.Append(req.State.GetAttribute(ctx, path.Root("family_sharable"), &state)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("family_sharable"), &plan)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if state.ValueBool() && !plan.IsUnknown() && !plan.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("family_sharable"),
+			"Invalid Configuration",
+			fmt.Sprintf("Family Sharing cannot be turned off once it has been enabled for %s.", product),
+		)
+	}
+	return diags
+}
+
+func (r *InAppPurchaseResource) populateState(ctx context.Context, data *InAppPurchaseResourceModel, purchase *iap.InAppPurchase, localizations []iap.Localization) diag.Diagnostics {
+	data.ID = types.StringValue(purchase.ID)
+	data.AppID = types.StringValue(purchase.AppID)
+	data.ProductID = types.StringValue(purchase.ProductID)
+	data.ReferenceName = types.StringValue(purchase.Name)
+	data.Type = types.StringValue(purchase.Type)
+	data.FamilySharable = types.BoolValue(purchase.FamilySharable)
+	data.ReviewNote = optionalString(purchase.ReviewNote)
+	data.State = optionalString(purchase.State)
+
+	converted := []productLocalization{}
+	for _, localization := range localizations {
+		converted = append(converted, productLocalization{
+			ID:          localization.ID,
+			Locale:      localization.Locale,
+			Name:        localization.Name,
+			Description: localization.Description,
+		})
+	}
+
+	var diags diag.Diagnostics
+	data.Localizations, diags = productLocalizationsValue(ctx, converted)
+	return diags
+}
+
+func (r *InAppPurchaseResource) inAppPurchase(data *InAppPurchaseResourceModel) iap.InAppPurchase {
+	return iap.InAppPurchase{
+		AppID:          data.AppID.ValueString(),
+		ProductID:      data.ProductID.ValueString(),
+		Name:           data.ReferenceName.ValueString(),
+		Type:           data.Type.ValueString(),
+		FamilySharable: data.FamilySharable.ValueBool(),
+		ReviewNote:     data.ReviewNote.ValueString(),
+	}
+}
+
+// syncLocalizations brings the localizations in line with the plan and
+// records the result, along with the in-app purchase, in data.
+func (r *InAppPurchaseResource) syncLocalizations(ctx context.Context, data *InAppPurchaseResourceModel, purchase *iap.InAppPurchase) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	existing, err := r.client.ListInAppPurchaseLocalizations(ctx, purchase.ID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list in-app purchase localizations, got error: %s", err))
+		return diags
+	}
+
+	current := []productLocalization{}
+	for _, localization := range existing {
+		current = append(current, productLocalization{
+			ID:          localization.ID,
+			Locale:      localization.Locale,
+			Name:        localization.Name,
+			Description: localization.Description,
+		})
+	}
+
+	toLocalization := func(localization productLocalization) iap.Localization {
+		return iap.Localization{
+			InAppPurchaseID: purchase.ID,
+			Locale:          localization.Locale,
+			Name:            localization.Name,
+			Description:     localization.Description,
+		}
+	}
+
+	diags.Append(reconcileProductLocalizations(ctx, data.Localizations, current, productLocalizationWriter{
+		create: func(ctx context.Context, localization productLocalization) error {
+			_, err := r.client.CreateInAppPurchaseLocalization(ctx, toLocalization(localization))
+			return err
+		},
+		modify: func(ctx context.Context, id string, localization productLocalization) error {
+			_, err := r.client.ModifyInAppPurchaseLocalization(ctx, id, toLocalization(localization))
+			return err
+		},
+		delete: r.client.DeleteInAppPurchaseLocalization,
+	})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	existing, err = r.client.ListInAppPurchaseLocalizations(ctx, purchase.ID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list in-app purchase localizations, got error: %s", err))
+		return diags
+	}
+
+	diags.Append(r.populateState(ctx, data, purchase, existing)...)
+	return diags
+}
+
+func (r *InAppPurchaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data InAppPurchaseResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	purchase, err := r.client.CreateInAppPurchase(ctx, r.inAppPurchase(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create in-app purchase, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an in-app purchase")
+
+	// Save the in-app purchase before adding its localizations.
+	created := data
+	resp.Diagnostics.Append(r.populateState(ctx, &created, purchase, nil)...)
+	resp.Diagnostics.Append(saveCreated(ctx, &resp.State, &created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncLocalizations(ctx, &data, purchase)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InAppPurchaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InAppPurchaseResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	purchase, err := r.client.GetInAppPurchase(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read in-app purchase, got error: %s", err))
+		return
+	}
+
+	localizations, err := r.client.ListInAppPurchaseLocalizations(ctx, purchase.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list in-app purchase localizations, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, purchase, localizations)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InAppPurchaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data InAppPurchaseResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	purchase, err := r.client.ModifyInAppPurchase(ctx, data.ID.ValueString(), r.inAppPurchase(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify in-app purchase, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified an in-app purchase")
+
+	resp.Diagnostics.Append(r.syncLocalizations(ctx, &data, purchase)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InAppPurchaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data InAppPurchaseResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteInAppPurchase(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete in-app purchase, got error: %s", err))
+		return
+	}
+}
+
+func (r *InAppPurchaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/iap"
+)
+
+type mockInAppPurchaseClient struct {
+	localizations []iap.Localization
+	createErr     error
+	created       []string
+	modified      []string
+	deleted       []string
+}
+
+func (m *mockInAppPurchaseClient) CreateInAppPurchase(ctx context.Context, purchase iap.InAppPurchase) (*iap.InAppPurchase, error) {
+	purchase.ID = "iap-id"
+	purchase.State = "MISSING_METADATA"
+	return &purchase, nil
+}
+
+func (m *mockInAppPurchaseClient) GetInAppPurchase(ctx context.Context, id string) (*iap.InAppPurchase, error) {
+	return &iap.InAppPurchase{ID: id}, nil
+}
+
+func (m *mockInAppPurchaseClient) ModifyInAppPurchase(ctx context.Context, id string, purchase iap.InAppPurchase) (*iap.InAppPurchase, error) {
+	purchase.ID = id
+	return &purchase, nil
+}
+
+func (m *mockInAppPurchaseClient) DeleteInAppPurchase(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *mockInAppPurchaseClient) ListInAppPurchaseLocalizations(ctx context.Context, purchaseID string) ([]iap.Localization, error) {
+	return m.localizations, nil
+}
+
+func (m *mockInAppPurchaseClient) CreateInAppPurchaseLocalization(ctx context.Context, localization iap.Localization) (*iap.Localization, error) {
+	if m.createErr != nil {
+		return nil, m.createErr
+	}
+	m.created = append(m.created, localization.Locale)
+	localization.ID = fmt.Sprintf("%s-id", localization.Locale)
+	m.localizations = append(m.localizations, localization)
+	return &localization, nil
+}
+
+func (m *mockInAppPurchaseClient) ModifyInAppPurchaseLocalization(ctx context.Context, id string, localization iap.Localization) (*iap.Localization, error) {
+	m.modified = append(m.modified, id)
+	for i := range m.localizations {
+		if m.localizations[i].ID == id {
+			m.localizations[i].Name = localization.Name
+			m.localizations[i].Description = localization.Description
+		}
+	}
+	return &localization, nil
+}
+
+func (m *mockInAppPurchaseClient) DeleteInAppPurchaseLocalization(ctx context.Context, id string) error {
+	m.deleted = append(m.deleted, id)
+	remaining := []iap.Localization{}
+	for _, localization := range m.localizations {
+		if localization.ID != id {
+			remaining = append(remaining, localization)
+		}
+	}
+	m.localizations = remaining
+	return nil
+}
+
+func inAppPurchaseResourceSchema() schema.Schema {
+	r := &InAppPurchaseResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func productLocalizationVal(locale string, name string, description interface{}) tftypes.Value {
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"locale":      tftypes.String,
+		"name":        tftypes.String,
+		"description": tftypes.String,
+	}}, map[string]tftypes.Value{
+		"locale":      tftypes.NewValue(tftypes.String, locale),
+		"name":        tftypes.NewValue(tftypes.String, name),
+		"description": tftypes.NewValue(tftypes.String, description),
+	})
+}
+
+func productLocalizationsVal(localizations ...tftypes.Value) tftypes.Value {
+	return tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"locale":      tftypes.String,
+		"name":        tftypes.String,
+		"description": tftypes.String,
+	}}}, localizations)
+}
+
+func inAppPurchaseVal(s schema.Schema, id interface{}, iapType string, familySharable bool, localizations tftypes.Value) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":              tftypes.NewValue(tftypes.String, id),
+		"app_id":          tftypes.NewValue(tftypes.String, "1234567890"),
+		"product_id":      tftypes.NewValue(tftypes.String, "com.example.app.coins.100"),
+		"reference_name":  tftypes.NewValue(tftypes.String, "100 Coins"),
+		"type":            tftypes.NewValue(tftypes.String, iapType),
+		"family_sharable": tftypes.NewValue(tftypes.Bool, familySharable),
+		"review_note":     tftypes.NewValue(tftypes.String, nil),
+		"state":           tftypes.NewValue(tftypes.String, nil),
+		"localization":    localizations,
+	})
+}
+
+func TestInAppPurchaseResource_Create_AddsLocalizations(t *testing.T) {
+	client := &mockInAppPurchaseClient{}
+	r := &InAppPurchaseResource{client: client}
+
+	s := inAppPurchaseResourceSchema()
+	planVal := inAppPurchaseVal(s, nil, "CONSUMABLE", false, productLocalizationsVal(
+		productLocalizationVal("en-GB", "100 Coins", "A small bag of coins."),
+		productLocalizationVal("fr-FR", "100 pièces", nil),
+	))
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.created) != 2 {
+		t.Errorf("expected 2 localizations to be created, got %v", client.created)
+	}
+
+	var data InAppPurchaseResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "iap-id" {
+		t.Errorf("expected ID 'iap-id', got %q", data.ID.ValueString())
+	}
+	if len(data.Localizations.Elements()) != 2 {
+		t.Errorf("expected 2 localizations in state, got %d", len(data.Localizations.Elements()))
+	}
+}
+
+func TestInAppPurchaseResource_Create_SavesPurchaseWhenLocalizationsFail(t *testing.T) {
+	client := &mockInAppPurchaseClient{createErr: fmt.Errorf("locale not supported")}
+	r := &InAppPurchaseResource{client: client}
+
+	s := inAppPurchaseResourceSchema()
+	planVal := inAppPurchaseVal(s, tftypes.UnknownValue, "CONSUMABLE", false, productLocalizationsVal(
+		productLocalizationVal("en-GB", "100 Coins", "A small bag of coins."),
+	))
+
+	state := createSavedState(t, r, s, planVal, "iap-id")
+
+	var data InAppPurchaseResourceModel
+	state.Get(context.Background(), &data)
+
+	if len(data.Localizations.Elements()) != 0 {
+		t.Errorf("expected no localizations in state, got %d", len(data.Localizations.Elements()))
+	}
+}
+
+func TestInAppPurchaseResource_Update_ReconcilesLocalizationsByLocale(t *testing.T) {
+	client := &mockInAppPurchaseClient{
+		localizations: []iap.Localization{
+			{ID: "en-GB-id", Locale: "en-GB", Name: "100 Coins"},
+			{ID: "de-DE-id", Locale: "de-DE", Name: "100 Münzen"},
+			{ID: "fr-FR-id", Locale: "fr-FR", Name: "100 pièces"},
+		},
+	}
+	r := &InAppPurchaseResource{client: client}
+
+	s := inAppPurchaseResourceSchema()
+	stateVal := inAppPurchaseVal(s, "iap-id", "CONSUMABLE", false, productLocalizationsVal(
+		productLocalizationVal("en-GB", "100 Coins", nil),
+		productLocalizationVal("de-DE", "100 Münzen", nil),
+		productLocalizationVal("fr-FR", "100 pièces", nil),
+	))
+	planVal := inAppPurchaseVal(s, "iap-id", "CONSUMABLE", false, productLocalizationsVal(
+		productLocalizationVal("en-GB", "100 Coins", nil),
+		productLocalizationVal("de-DE", "100 Goldmünzen", nil),
+		productLocalizationVal("es-ES", "100 monedas", nil),
+	))
+
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Update(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.created) != 1 || client.created[0] != "es-ES" {
+		t.Errorf("expected only es-ES to be created, got %v", client.created)
+	}
+	if len(client.modified) != 1 || client.modified[0] != "de-DE-id" {
+		t.Errorf("expected only de-DE to be modified, got %v", client.modified)
+	}
+	if len(client.deleted) != 1 || client.deleted[0] != "fr-FR-id" {
+		t.Errorf("expected only fr-FR to be deleted, got %v", client.deleted)
+	}
+}
+
+func TestInAppPurchaseResource_ValidateConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		iapType       string
+		localizations tftypes.Value
+		expectError   bool
+	}{
+		{name: "valid", iapType: "NON_CONSUMABLE", localizations: productLocalizationsVal(productLocalizationVal("en-GB", "Pro", nil))},
+		{name: "auto-renewable subscription", iapType: "AUTO_RENEWABLE", localizations: productLocalizationsVal(), expectError: true},
+		{name: "duplicate locale", iapType: "CONSUMABLE", localizations: productLocalizationsVal(
+			productLocalizationVal("en-GB", "Coins", nil),
+			productLocalizationVal("en-GB", "More Coins", nil),
+		), expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := InAppPurchaseResource{}
+
+			s := inAppPurchaseResourceSchema()
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: inAppPurchaseVal(s, nil, tc.iapType, false, tc.localizations)},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error=%t, got diagnostics %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestInAppPurchaseResource_ModifyPlan_RejectsDisablingFamilySharing(t *testing.T) {
+	r := InAppPurchaseResource{}
+
+	s := inAppPurchaseResourceSchema()
+	stateVal := inAppPurchaseVal(s, "iap-id", "NON_CONSUMABLE", true, productLocalizationsVal())
+	planVal := inAppPurchaseVal(s, "iap-id", "NON_CONSUMABLE", false, productLocalizationsVal())
+
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.ModifyPlanResponse{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+
+	r.ModifyPlan(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when disabling Family Sharing")
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/versions"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PhasedReleaseResource{}
+var _ resource.ResourceWithImportState = &PhasedReleaseResource{}
+var _ resource.ResourceWithValidateConfig = &PhasedReleaseResource{}
+
+// phasedReleaseStates are the states that can be requested in configuration.
+// Apple reports `INACTIVE` until the version is released, but it cannot be set.
+var phasedReleaseStates = []string{"ACTIVE", "PAUSED", "COMPLETE"}
+
+type phasedReleaseClient interface {
+	CreatePhasedRelease(ctx context.Context, release versions.PhasedRelease) (*versions.PhasedRelease, error)
+	GetPhasedRelease(ctx context.Context, id string) (*versions.PhasedRelease, error)
+	ModifyPhasedRelease(ctx context.Context, id string, release versions.PhasedRelease) (*versions.PhasedRelease, error)
+	DeletePhasedRelease(ctx context.Context, id string) error
+}
+
+func NewPhasedReleaseResource() resource.Resource {
+	return &PhasedReleaseResource{}
+}
+
+// PhasedReleaseResource defines the resource implementation.
+type PhasedReleaseResource struct {
+	client phasedReleaseClient
+}
+
+// PhasedReleaseResourceModel describes the resource data model.
+type PhasedReleaseResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	AppStoreVersionID  types.String `tfsdk:"app_store_version_id"`
+	State              types.String `tfsdk:"state"`
+	CurrentState       types.String `tfsdk:"current_state"`
+	StartDate          types.String `tfsdk:"start_date"`
+	CurrentDayNumber   types.Int64  `tfsdk:"current_day_number"`
+	TotalPauseDuration types.Int64  `tfsdk:"total_pause_duration"`
+}
+
+func (r *PhasedReleaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_phased_release"
+}
+
+func (r *PhasedReleaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the 7-day phased release of an App Store version, allowing the rollout to be paused, resumed or completed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the phased release.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_store_version_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the App Store version to release in phases.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The requested state of the phased release: `ACTIVE`, `PAUSED` or `COMPLETE`. Apple applies it once the version is released; see `current_state` for the state Apple reports.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"current_state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The state Apple reports for the phased release. This is `INACTIVE` until the version is released.",
+			},
+			"start_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date the phased release started.",
+			},
+			"current_day_number": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The current day of the 7-day phased release.",
+			},
+			"total_pause_duration": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The total number of days the phased release has been paused.",
+			},
+		},
+	}
+}
+
+func (r *PhasedReleaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(phasedReleaseClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected phasedReleaseClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r PhasedReleaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PhasedReleaseResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.State.IsNull() && !data.State.IsUnknown() && !slices.Contains(phasedReleaseStates, data.State.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("state"),
+			"Invalid Configuration",
+			fmt.Sprintf("`state` must be one of %s, got %q.", strings.Join(phasedReleaseStates, ", "), data.State.ValueString()),
+		)
+	}
+}
+
+func (r *PhasedReleaseResource) populateState(data *PhasedReleaseResourceModel, release *versions.PhasedRelease) {
+	data.ID = types.StringValue(release.ID)
+	data.AppStoreVersionID = types.StringValue(release.AppStoreVersionID)
+	data.CurrentState = types.StringValue(release.State)
+	// Apple reports INACTIVE until the version is released, so keep the requested state until then.
+	if release.State != "INACTIVE" || data.State.IsNull() || data.State.IsUnknown() {
+		data.State = types.StringValue(release.State)
+	}
+	data.StartDate = optionalString(release.StartDate)
+	data.CurrentDayNumber = types.Int64Value(release.CurrentDayNumber)
+	data.TotalPauseDuration = types.Int64Value(release.TotalPauseDuration)
+}
+
+func (r *PhasedReleaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PhasedReleaseResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	release, err := r.client.CreatePhasedRelease(ctx, versions.PhasedRelease{
+		AppStoreVersionID: data.AppStoreVersionID.ValueString(),
+		State:             data.State.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create phased release, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a phased release")
+
+	r.populateState(&data, release)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PhasedReleaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PhasedReleaseResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	release, err := r.client.GetPhasedRelease(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read phased release, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, release)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PhasedReleaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PhasedReleaseResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	release, err := r.client.ModifyPhasedRelease(ctx, data.ID.ValueString(), versions.PhasedRelease{
+		State: data.State.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify phased release, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified a phased release")
+
+	r.populateState(&data, release)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PhasedReleaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PhasedReleaseResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apple only allows a phased release to be removed before the version is released.
+	if data.CurrentState.ValueString() != "INACTIVE" {
+		resp.Diagnostics.AddWarning(
+			"Phased release not deleted",
+			fmt.Sprintf("The phased release is %s and can no longer be removed from the App Store version. It has been removed from Terraform state only.", data.CurrentState.ValueString()),
+		)
+		return
+	}
+
+	err := r.client.DeletePhasedRelease(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete phased release, got error: %s", err))
+		return
+	}
+}
+
+func (r *PhasedReleaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/versions"
+)
+
+type mockPhasedReleaseClient struct {
+	modifyFn   func(ctx context.Context, id string, release versions.PhasedRelease) (*versions.PhasedRelease, error)
+	deletedIDs []string
+}
+
+func (m *mockPhasedReleaseClient) CreatePhasedRelease(ctx context.Context, release versions.PhasedRelease) (*versions.PhasedRelease, error) {
+	// Apple reports INACTIVE until the version is released, whatever state was requested.
+	release.ID = "release-id"
+	release.State = "INACTIVE"
+	return &release, nil
+}
+
+func (m *mockPhasedReleaseClient) GetPhasedRelease(ctx context.Context, id string) (*versions.PhasedRelease, error) {
+	return &versions.PhasedRelease{ID: id}, nil
+}
+
+func (m *mockPhasedReleaseClient) ModifyPhasedRelease(ctx context.Context, id string, release versions.PhasedRelease) (*versions.PhasedRelease, error) {
+	if m.modifyFn != nil {
+		return m.modifyFn(ctx, id, release)
+	}
+	return &versions.PhasedRelease{ID: id}, nil
+}
+
+func (m *mockPhasedReleaseClient) DeletePhasedRelease(ctx context.Context, id string) error {
+	m.deletedIDs = append(m.deletedIDs, id)
+	return nil
+}
+
+func phasedReleaseResourceSchema() schema.Schema {
+	r := &PhasedReleaseResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func phasedReleaseVal(s schema.Schema, id interface{}, state interface{}, currentState interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                   tftypes.NewValue(tftypes.String, id),
+		"app_store_version_id": tftypes.NewValue(tftypes.String, "version-id"),
+		"state":                tftypes.NewValue(tftypes.String, state),
+		"current_state":        tftypes.NewValue(tftypes.String, currentState),
+		"start_date":           tftypes.NewValue(tftypes.String, nil),
+		"current_day_number":   tftypes.NewValue(tftypes.Number, nil),
+		"total_pause_duration": tftypes.NewValue(tftypes.Number, nil),
+	})
+}
+
+func TestPhasedReleaseResource_Update_PausesRollout(t *testing.T) {
+	var capturedState string
+
+	r := &PhasedReleaseResource{
+		client: &mockPhasedReleaseClient{
+			modifyFn: func(ctx context.Context, id string, release versions.PhasedRelease) (*versions.PhasedRelease, error) {
+				capturedState = release.State
+				return &versions.PhasedRelease{
+					ID:                 id,
+					AppStoreVersionID:  "version-id",
+					State:              release.State,
+					StartDate:          "2026-10-01T09:00:00Z",
+					CurrentDayNumber:   3,
+					TotalPauseDuration: 1,
+				}, nil
+			},
+		},
+	}
+
+	s := phasedReleaseResourceSchema()
+	stateVal := phasedReleaseVal(s, "release-id", "ACTIVE", "ACTIVE")
+	planVal := phasedReleaseVal(s, "release-id", "PAUSED", tftypes.UnknownValue)
+
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Update(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if capturedState != "PAUSED" {
+		t.Errorf("expected ModifyPhasedRelease called with 'PAUSED', got %q", capturedState)
+	}
+
+	var data PhasedReleaseResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.CurrentDayNumber.ValueInt64() != 3 {
+		t.Errorf("expected CurrentDayNumber 3, got %d", data.CurrentDayNumber.ValueInt64())
+	}
+	if data.TotalPauseDuration.ValueInt64() != 1 {
+		t.Errorf("expected TotalPauseDuration 1, got %d", data.TotalPauseDuration.ValueInt64())
+	}
+}
+
+func TestPhasedReleaseResource_Create_KeepsRequestedStateUntilRelease(t *testing.T) {
+	r := &PhasedReleaseResource{client: &mockPhasedReleaseClient{}}
+
+	s := phasedReleaseResourceSchema()
+	planVal := phasedReleaseVal(s, tftypes.UnknownValue, "ACTIVE", tftypes.UnknownValue)
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+
+	var data PhasedReleaseResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.State.ValueString() != "ACTIVE" {
+		t.Errorf("expected State 'ACTIVE', got %q", data.State.ValueString())
+	}
+	if data.CurrentState.ValueString() != "INACTIVE" {
+		t.Errorf("expected CurrentState 'INACTIVE', got %q", data.CurrentState.ValueString())
+	}
+}
+
+func TestPhasedReleaseResource_ValidateConfig_RejectsUnknownState(t *testing.T) {
+	r := PhasedReleaseResource{}
+
+	s := phasedReleaseResourceSchema()
+	req := resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: s, Raw: phasedReleaseVal(s, nil, "INACTIVE", nil)},
+	}
+	resp := &resource.ValidateConfigResponse{}
+
+	r.ValidateConfig(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for a state that cannot be requested")
+	}
+}
+
+func TestPhasedReleaseResource_Delete_OnlyDeletesInactiveRelease(t *testing.T) {
+	for _, tc := range []struct {
+		state         string
+		expectDeleted bool
+	}{
+		{state: "INACTIVE", expectDeleted: true},
+		{state: "ACTIVE", expectDeleted: false},
+	} {
+		t.Run(tc.state, func(t *testing.T) {
+			client := &mockPhasedReleaseClient{}
+			r := &PhasedReleaseResource{client: client}
+
+			s := phasedReleaseResourceSchema()
+			req := resource.DeleteRequest{
+				State: tfsdk.State{Schema: s, Raw: phasedReleaseVal(s, "release-id", "ACTIVE", tc.state)},
+			}
+			resp := &resource.DeleteResponse{}
+
+			r.Delete(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+			}
+			if deleted := len(client.deletedIDs) == 1; deleted != tc.expectDeleted {
+				t.Errorf("expected deleted=%t, got %t", tc.expectDeleted, deleted)
+			}
+		})
+	}
+}
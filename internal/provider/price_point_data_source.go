@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/oliver-binns/appstore-go/iap"
+	"github.com/oliver-binns/appstore-go/pricing"
+	"github.com/oliver-binns/appstore-go/subscriptions"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PricePointDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &PricePointDataSource{}
+
+type pricePointClient interface {
+	ListAppPricePoints(ctx context.Context, appID string, territory string) ([]pricing.PricePoint, error)
+	ListInAppPurchasePricePoints(ctx context.Context, purchaseID string, territory string) ([]iap.PricePoint, error)
+	ListSubscriptionPricePoints(ctx context.Context, subscriptionID string, territory string) ([]subscriptions.PricePoint, error)
+}
+
+func NewPricePointDataSource() datasource.DataSource {
+	return &PricePointDataSource{}
+}
+
+// PricePointDataSource defines the data source implementation.
+type PricePointDataSource struct {
+	client pricePointClient
+}
+
+// PricePointDataSourceModel describes the data source data model.
+type PricePointDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	AppID           types.String `tfsdk:"app_id"`
+	InAppPurchaseID types.String `tfsdk:"in_app_purchase_id"`
+	SubscriptionID  types.String `tfsdk:"subscription_id"`
+	Territory       types.String `tfsdk:"territory"`
+	CustomerPrice   types.String `tfsdk:"customer_price"`
+	Proceeds        types.String `tfsdk:"proceeds"`
+}
+
+// pricePoint is a price point for either kind of product.
+type pricePoint struct {
+	ID            string
+	CustomerPrice string
+	Proceeds      string
+}
+
+func (d *PricePointDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_price_point"
+}
+
+func (d *PricePointDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up the price point of an app, in-app purchase or subscription that charges customers " +
+			"a given price in a territory. Set exactly one of `app_id`, `in_app_purchase_id` or `subscription_id`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the price point.",
+			},
+			"app_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The identifier of the app to find a price point for.",
+			},
+			"in_app_purchase_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The identifier of the in-app purchase to find a price point for.",
+			},
+			"subscription_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The identifier of the subscription to find a price point for.",
+			},
+			"territory": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The three-letter code of the territory, e.g. `GBR`.",
+			},
+			"customer_price": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The price customers pay in the territory's currency, e.g. `4.99`.",
+			},
+			"proceeds": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The amount you receive from each sale at this price.",
+			},
+		},
+	}
+}
+
+func (d *PricePointDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(pricePointClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected pricePointClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d PricePointDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data PricePointDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AppID.IsUnknown() || data.InAppPurchaseID.IsUnknown() || data.SubscriptionID.IsUnknown() {
+		return
+	}
+
+	set := 0
+	for _, id := range []types.String{data.AppID, data.InAppPurchaseID, data.SubscriptionID} {
+		if !id.IsNull() {
+			set++
+		}
+	}
+	if set != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"Exactly one of `app_id`, `in_app_purchase_id` or `subscription_id` must be set.",
+		)
+	}
+
+	if !data.CustomerPrice.IsNull() && !data.CustomerPrice.IsUnknown() {
+		if _, err := strconv.ParseFloat(data.CustomerPrice.ValueString(), 64); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("customer_price"),
+				"Invalid Configuration",
+				fmt.Sprintf("`customer_price` must be a number, got %q.", data.CustomerPrice.ValueString()),
+			)
+		}
+	}
+}
+
+func (d *PricePointDataSource) listPricePoints(ctx context.Context, data PricePointDataSourceModel) ([]pricePoint, error) {
+	points := []pricePoint{}
+
+	if !data.AppID.IsNull() {
+		found, err := d.client.ListAppPricePoints(ctx, data.AppID.ValueString(), data.Territory.ValueString())
+		for _, point := range found {
+			points = append(points, pricePoint{ID: point.ID, CustomerPrice: point.CustomerPrice, Proceeds: point.Proceeds})
+		}
+		return points, err
+	}
+
+	if !data.InAppPurchaseID.IsNull() {
+		found, err := d.client.ListInAppPurchasePricePoints(ctx, data.InAppPurchaseID.ValueString(), data.Territory.ValueString())
+		for _, point := range found {
+			points = append(points, pricePoint{ID: point.ID, CustomerPrice: point.CustomerPrice, Proceeds: point.Proceeds})
+		}
+		return points, err
+	}
+
+	found, err := d.client.ListSubscriptionPricePoints(ctx, data.SubscriptionID.ValueString(), data.Territory.ValueString())
+	for _, point := range found {
+		points = append(points, pricePoint{ID: point.ID, CustomerPrice: point.CustomerPrice, Proceeds: point.Proceeds})
+	}
+	return points, err
+}
+
+func (d *PricePointDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PricePointDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	points, err := d.listPricePoints(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list price points, got error: %s", err))
+		return
+	}
+
+	// Compare numerically so that "5" matches Apple's "5.00".
+	want, _ := strconv.ParseFloat(data.CustomerPrice.ValueString(), 64)
+	for _, point := range points {
+		price, err := strconv.ParseFloat(point.CustomerPrice, 64)
+		if err != nil || price != want {
+			continue
+		}
+
+		data.ID = types.StringValue(point.ID)
+		data.Proceeds = types.StringValue(point.Proceeds)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Price point not found",
+		fmt.Sprintf("No price point of %s was found in territory %q.", data.CustomerPrice.ValueString(), data.Territory.ValueString()),
+	)
+}
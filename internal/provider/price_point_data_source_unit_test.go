@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/iap"
+	"github.com/oliver-binns/appstore-go/pricing"
+	"github.com/oliver-binns/appstore-go/subscriptions"
+)
+
+type mockPricePointClient struct {
+	territory string
+}
+
+func (m *mockPricePointClient) ListAppPricePoints(ctx context.Context, appID string, territory string) ([]pricing.PricePoint, error) {
+	m.territory = territory
+	return []pricing.PricePoint{
+		{ID: "app-point-299", Territory: territory, CustomerPrice: "2.99", Proceeds: "2.09"},
+	}, nil
+}
+
+func (m *mockPricePointClient) ListInAppPurchasePricePoints(ctx context.Context, purchaseID string, territory string) ([]iap.PricePoint, error) {
+	m.territory = territory
+	return []iap.PricePoint{
+		{ID: "iap-point-099", Territory: territory, CustomerPrice: "0.99", Proceeds: "0.69"},
+		{ID: "iap-point-500", Territory: territory, CustomerPrice: "5.00", Proceeds: "3.50"},
+	}, nil
+}
+
+func (m *mockPricePointClient) ListSubscriptionPricePoints(ctx context.Context, subscriptionID string, territory string) ([]subscriptions.PricePoint, error) {
+	m.territory = territory
+	return []subscriptions.PricePoint{
+		{ID: "subscription-point-499", Territory: territory, CustomerPrice: "4.99", Proceeds: "3.49"},
+	}, nil
+}
+
+func pricePointDataSourceSchema() schema.Schema {
+	d := &PricePointDataSource{}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func pricePointVal(s schema.Schema, appID interface{}, purchaseID interface{}, subscriptionID interface{}, customerPrice string) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                 tftypes.NewValue(tftypes.String, nil),
+		"app_id":             tftypes.NewValue(tftypes.String, appID),
+		"in_app_purchase_id": tftypes.NewValue(tftypes.String, purchaseID),
+		"subscription_id":    tftypes.NewValue(tftypes.String, subscriptionID),
+		"territory":          tftypes.NewValue(tftypes.String, "GBR"),
+		"customer_price":     tftypes.NewValue(tftypes.String, customerPrice),
+		"proceeds":           tftypes.NewValue(tftypes.String, nil),
+	})
+}
+
+func TestPricePointDataSource_Read_MatchesCustomerPriceNumerically(t *testing.T) {
+	client := &mockPricePointClient{}
+	d := &PricePointDataSource{client: client}
+
+	s := pricePointDataSourceSchema()
+	configVal := pricePointVal(s, nil, "iap-id", nil, "5")
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: s, Raw: configVal},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: configVal},
+	}
+
+	d.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if client.territory != "GBR" {
+		t.Errorf("expected price points to be filtered to GBR, got %q", client.territory)
+	}
+
+	var data PricePointDataSourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "iap-point-500" {
+		t.Errorf("expected ID 'iap-point-500', got %q", data.ID.ValueString())
+	}
+	if data.Proceeds.ValueString() != "3.50" {
+		t.Errorf("expected proceeds '3.50', got %q", data.Proceeds.ValueString())
+	}
+}
+
+func TestPricePointDataSource_Read_ErrorsWhenNoPricePointMatches(t *testing.T) {
+	d := &PricePointDataSource{client: &mockPricePointClient{}}
+
+	s := pricePointDataSourceSchema()
+	configVal := pricePointVal(s, nil, nil, "subscription-id", "4.49")
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: s, Raw: configVal},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: configVal},
+	}
+
+	d.Read(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when no price point matches")
+	}
+}
+
+func TestPricePointDataSource_ValidateConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		appID          interface{}
+		purchaseID     interface{}
+		subscriptionID interface{}
+		customerPrice  string
+		expectError    bool
+	}{
+		{name: "app", appID: "app-id", customerPrice: "2.99"},
+		{name: "in-app purchase", purchaseID: "iap-id", customerPrice: "0.99"},
+		{name: "subscription", subscriptionID: "subscription-id", customerPrice: "4.99"},
+		{name: "neither product", customerPrice: "0.99", expectError: true},
+		{name: "both products", purchaseID: "iap-id", subscriptionID: "subscription-id", customerPrice: "0.99", expectError: true},
+		{name: "app and product", appID: "app-id", purchaseID: "iap-id", customerPrice: "0.99", expectError: true},
+		{name: "not a number", purchaseID: "iap-id", customerPrice: "£0.99", expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := PricePointDataSource{}
+
+			s := pricePointDataSourceSchema()
+			req := datasource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: pricePointVal(s, tc.appID, tc.purchaseID, tc.subscriptionID, tc.customerPrice)},
+			}
+			resp := &datasource.ValidateConfigResponse{}
+
+			d.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error=%t, got diagnostics %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// priceDateFormat is the format App Store Connect uses for price start dates.
+const priceDateFormat = "2006-01-02"
+
+// priceModel describes a `price` block shared by the app, in-app purchase
+// and subscription price schedule resources.
+type priceModel struct {
+	Territory    types.String `tfsdk:"territory"`
+	PricePointID types.String `tfsdk:"price_point_id"`
+	StartDate    types.String `tfsdk:"start_date"`
+}
+
+var priceAttrTypes = map[string]attr.Type{
+	"territory":      types.StringType,
+	"price_point_id": types.StringType,
+	"start_date":     types.StringType,
+}
+
+// scheduledPrice is a price in a territory, starting on StartDate or, when
+// StartDate is empty, straight away.
+type scheduledPrice struct {
+	Territory    string
+	PricePointID string
+	StartDate    string
+}
+
+func priceBlock(product string) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		MarkdownDescription: fmt.Sprintf("A price for the %s. Prices in the base territory are equalized automatically "+
+			"across every other territory; prices in other territories override the equalized price there.", product),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"territory": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "The three-letter code of the territory the price applies in, e.g. `GBR`.",
+				},
+				"price_point_id": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "The identifier of the price point, usually from the `appstoreconnect_price_point` data source.",
+				},
+				"start_date": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "The date the price takes effect, in `YYYY-MM-DD` format. Omit for the current price.",
+				},
+			},
+		},
+	}
+}
+
+// validatePrices checks that the schedule has a current price in the base
+// territory, that start dates are well-formed, and that no territory has
+// two prices starting on the same date.
+func validatePrices(ctx context.Context, baseTerritory types.String, prices types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if baseTerritory.IsUnknown() || prices.IsNull() || prices.IsUnknown() {
+		return diags
+	}
+
+	var models []priceModel
+	diags.Append(prices.ElementsAs(ctx, &models, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	hasBasePrice := false
+	seen := map[scheduledPrice]bool{}
+	for _, model := range models {
+		if model.Territory.IsUnknown() || model.StartDate.IsUnknown() {
+			// Can't tell which price this is until apply.
+			hasBasePrice = true
+			continue
+		}
+
+		key := scheduledPrice{Territory: model.Territory.ValueString(), StartDate: model.StartDate.ValueString()}
+		if key.StartDate != "" {
+			if _, err := time.Parse(priceDateFormat, key.StartDate); err != nil {
+				diags.AddAttributeError(
+					path.Root("price"),
+					"Invalid Configuration",
+					fmt.Sprintf("`start_date` must be in YYYY-MM-DD format, got %q.", key.StartDate),
+				)
+			}
+		}
+		if seen[key] {
+			diags.AddAttributeError(
+				path.Root("price"),
+				"Invalid Configuration",
+				fmt.Sprintf("Territory %q has more than one price starting on the same date.", key.Territory),
+			)
+		}
+		seen[key] = true
+
+		if key.Territory == baseTerritory.ValueString() && key.StartDate == "" {
+			hasBasePrice = true
+		}
+	}
+
+	if !hasBasePrice {
+		diags.AddAttributeError(
+			path.Root("price"),
+			"Invalid Configuration",
+			fmt.Sprintf("A `price` without a `start_date` is required in the base territory %q.", baseTerritory.ValueString()),
+		)
+	}
+	return diags
+}
+
+// scheduledPricesFrom reads the configured prices from a `price` set.
+func scheduledPricesFrom(ctx context.Context, prices types.Set) ([]scheduledPrice, diag.Diagnostics) {
+	var models []priceModel
+	diags := prices.ElementsAs(ctx, &models, false)
+
+	scheduled := []scheduledPrice{}
+	for _, model := range models {
+		scheduled = append(scheduled, scheduledPrice{
+			Territory:    model.Territory.ValueString(),
+			PricePointID: model.PricePointID.ValueString(),
+			StartDate:    model.StartDate.ValueString(),
+		})
+	}
+	return scheduled, diags
+}
+
+// pricesValue converts prices from App Store Connect into a set for state.
+func pricesValue(ctx context.Context, prices []scheduledPrice) (types.Set, diag.Diagnostics) {
+	models := []priceModel{}
+	for _, price := range prices {
+		models = append(models, priceModel{
+			Territory:    types.StringValue(price.Territory),
+			PricePointID: types.StringValue(price.PricePointID),
+			StartDate:    optionalString(price.StartDate),
+		})
+	}
+	return types.SetValueFrom(ctx, types.ObjectType{AttrTypes: priceAttrTypes}, models)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// productLocalizationModel describes a `localization` block shared by the
+// in-app purchase and subscription resources.
+type productLocalizationModel struct {
+	Locale      types.String `tfsdk:"locale"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+var productLocalizationAttrTypes = map[string]attr.Type{
+	"locale":      types.StringType,
+	"name":        types.StringType,
+	"description": types.StringType,
+}
+
+// productLocalization is a localization as stored in App Store Connect.
+type productLocalization struct {
+	ID          string
+	Locale      string
+	Name        string
+	Description string
+}
+
+// productLocalizationWriter applies localization changes for a single product.
+type productLocalizationWriter struct {
+	create func(ctx context.Context, localization productLocalization) error
+	modify func(ctx context.Context, id string, localization productLocalization) error
+	delete func(ctx context.Context, id string) error
+}
+
+func productLocalizationBlock(product string) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		MarkdownDescription: fmt.Sprintf("The name and description of the %s shown on the App Store in a locale.", product),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"locale": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "The locale of the localization, e.g. `en-GB`.",
+				},
+				"name": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "The display name shown to customers.",
+				},
+				"description": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "The description shown to customers.",
+				},
+			},
+		},
+	}
+}
+
+// validateProductLocalizations reports locales that are configured more than once.
+func validateProductLocalizations(ctx context.Context, localizations types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if localizations.IsNull() || localizations.IsUnknown() {
+		return diags
+	}
+
+	var models []productLocalizationModel
+	diags.Append(localizations.ElementsAs(ctx, &models, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	seen := map[string]bool{}
+	for _, model := range models {
+		if model.Locale.IsUnknown() {
+			continue
+		}
+		locale := model.Locale.ValueString()
+		if seen[locale] {
+			diags.AddAttributeError(
+				path.Root("localization"),
+				"Invalid Configuration",
+				fmt.Sprintf("Locale %q is configured more than once.", locale),
+			)
+		}
+		seen[locale] = true
+	}
+	return diags
+}
+
+// productLocalizationsValue converts localizations from App Store Connect into a set for state.
+func productLocalizationsValue(ctx context.Context, localizations []productLocalization) (types.Set, diag.Diagnostics) {
+	models := []productLocalizationModel{}
+	for _, localization := range localizations {
+		models = append(models, productLocalizationModel{
+			Locale:      types.StringValue(localization.Locale),
+			Name:        types.StringValue(localization.Name),
+			Description: optionalString(localization.Description),
+		})
+	}
+	return types.SetValueFrom(ctx, types.ObjectType{AttrTypes: productLocalizationAttrTypes}, models)
+}
+
+// reconcileProductLocalizations creates, modifies and deletes localizations,
+// matched by locale, so that App Store Connect matches the plan.
+func reconcileProductLocalizations(ctx context.Context, planned types.Set, existing []productLocalization, writer productLocalizationWriter) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var models []productLocalizationModel
+	diags.Append(planned.ElementsAs(ctx, &models, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	current := map[string]productLocalization{}
+	for _, localization := range existing {
+		current[localization.Locale] = localization
+	}
+
+	for _, model := range models {
+		localization := productLocalization{
+			Locale:      model.Locale.ValueString(),
+			Name:        model.Name.ValueString(),
+			Description: model.Description.ValueString(),
+		}
+
+		match, ok := current[localization.Locale]
+		delete(current, localization.Locale)
+
+		var err error
+		switch {
+		case !ok:
+			err = writer.create(ctx, localization)
+		case match.Name != localization.Name || match.Description != localization.Description:
+			err = writer.modify(ctx, match.ID, localization)
+		}
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update %s localization, got error: %s", localization.Locale, err))
+			return diags
+		}
+	}
+
+	for locale, localization := range current {
+		if err := writer.delete(ctx, localization.ID); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete %s localization, got error: %s", locale, err))
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -78,21 +78,69 @@ func (p *AppStoreConnectProvider) Configure(ctx context.Context, req provider.Co
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *AppStoreConnectProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAgeRatingDeclarationResource,
+		NewAppAvailabilityResource,
+		NewAppCustomProductPageResource,
+		NewAppEncryptionDeclarationResource,
+		NewAppEncryptionDeclarationBuildResource,
+		NewAppEventResource,
+		NewAppEventScreenshotResource,
+		NewAppEventVideoClipResource,
+		NewAppInfoResource,
+		NewAppInfoLocalizationResource,
+		NewAppPreviewResource,
+		NewAppPreviewSetResource,
+		NewAppPriceScheduleResource,
+		NewAppStoreReviewDetailResource,
+		NewAppStoreVersionExperimentResource,
+		NewAppStoreVersionExperimentTreatmentResource,
+		NewBetaAppLocalizationResource,
+		NewBetaAppReviewDetailResource,
+		NewBetaBuildLocalizationResource,
+		NewBetaGroupResource,
+		NewBetaGroupBuildResource,
+		NewBetaGroupTestersResource,
+		NewBetaTesterResource,
 		NewDeviceResource,
+		NewInAppPurchaseResource,
+		NewInAppPurchasePriceScheduleResource,
+		NewPhasedReleaseResource,
+		NewReviewSubmissionResource,
+		NewSandboxTesterResource,
+		NewScreenshotResource,
+		NewScreenshotSetResource,
+		NewSubscriptionResource,
+		NewSubscriptionGroupResource,
+		NewSubscriptionIntroductoryOfferResource,
+		NewSubscriptionOfferCodeResource,
+		NewSubscriptionOfferCodeCustomCodeResource,
+		NewSubscriptionOfferCodeOneTimeCodesResource,
+		NewSubscriptionPriceScheduleResource,
+		NewSubscriptionPromotionalOfferResource,
+		NewSubscriptionWinBackOfferResource,
 		NewUserResource,
 	}
 }
 
 func (p *AppStoreConnectProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
-	return []func() ephemeral.EphemeralResource{}
+	return []func() ephemeral.EphemeralResource{
+		NewSubscriptionOfferCodeOneTimeCodeValuesEphemeralResource,
+	}
 }
 
 func (p *AppStoreConnectProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewAppCategoriesDataSource,
+		NewBuildDataSource,
+		NewPricePointDataSource,
+		NewSandboxTesterDataSource,
+		NewTerritoriesDataSource,
+	}
 }
 
 func (p *AppStoreConnectProvider) Functions(ctx context.Context) []func() function.Function {
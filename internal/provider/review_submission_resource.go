@@ -0,0 +1,361 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/reviews"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ReviewSubmissionResource{}
+var _ resource.ResourceWithImportState = &ReviewSubmissionResource{}
+var _ resource.ResourceWithValidateConfig = &ReviewSubmissionResource{}
+
+// reviewSubmissionItemTypes are the kinds of resource that can be added to a review submission.
+var reviewSubmissionItemTypes = []string{
+	"APP_STORE_VERSION",
+	"APP_CUSTOM_PRODUCT_PAGE_VERSION",
+	"APP_EVENT",
+	"APP_STORE_VERSION_EXPERIMENT",
+}
+
+// reviewSubmissionCancelableStates are the states in which a review submission
+// is still open and can be withdrawn. Apple allows only one open submission per
+// app and platform, so a draft must be canceled too before another is created.
+var reviewSubmissionCancelableStates = []string{
+	"READY_FOR_REVIEW",
+	"WAITING_FOR_REVIEW",
+	"IN_REVIEW",
+	"UNRESOLVED_ISSUES",
+}
+
+type reviewSubmissionClient interface {
+	CreateReviewSubmission(ctx context.Context, submission reviews.Submission) (*reviews.Submission, error)
+	AddReviewSubmissionItem(ctx context.Context, submissionID string, item reviews.Item) (*reviews.Item, error)
+	GetReviewSubmission(ctx context.Context, id string) (*reviews.Submission, error)
+	SubmitReviewSubmission(ctx context.Context, id string) (*reviews.Submission, error)
+	CancelReviewSubmission(ctx context.Context, id string) (*reviews.Submission, error)
+}
+
+func NewReviewSubmissionResource() resource.Resource {
+	return &ReviewSubmissionResource{}
+}
+
+// ReviewSubmissionResource defines the resource implementation.
+type ReviewSubmissionResource struct {
+	client reviewSubmissionClient
+}
+
+// ReviewSubmissionResourceModel describes the resource data model.
+type ReviewSubmissionResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	AppID         types.String `tfsdk:"app_id"`
+	Platform      types.String `tfsdk:"platform"`
+	Items         types.Set    `tfsdk:"items"`
+	Submitted     types.Bool   `tfsdk:"submitted"`
+	State         types.String `tfsdk:"state"`
+	SubmittedDate types.String `tfsdk:"submitted_date"`
+}
+
+// ReviewSubmissionItemModel describes a single item within a review submission.
+type ReviewSubmissionItemModel struct {
+	Type       types.String `tfsdk:"type"`
+	ResourceID types.String `tfsdk:"resource_id"`
+}
+
+var reviewSubmissionItemAttrTypes = map[string]attr.Type{
+	"type":        types.StringType,
+	"resource_id": types.StringType,
+}
+
+func (r *ReviewSubmissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_review_submission"
+}
+
+func (r *ReviewSubmissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a submission of an app's versions, custom product pages, in-app events or experiments for App Review.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the review submission.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the app being submitted.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"platform": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The platform being submitted (e.g. `IOS`, `MAC_OS`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"items": schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The items included in the submission. Changing the items creates a new submission.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The kind of item: `APP_STORE_VERSION`, `APP_CUSTOM_PRODUCT_PAGE_VERSION`, `APP_EVENT` or `APP_STORE_VERSION_EXPERIMENT`.",
+						},
+						"resource_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The identifier of the version, custom product page version, event or experiment to submit.",
+						},
+					},
+				},
+			},
+			"submitted": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the submission should be sent to App Review. Setting this back to `false` cancels a submission that is still waiting for or in review.",
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The state of the submission (e.g. `READY_FOR_REVIEW`, `WAITING_FOR_REVIEW`, `IN_REVIEW`, `UNRESOLVED_ISSUES`, `COMPLETE`).",
+			},
+			"submitted_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date the submission was sent to App Review.",
+			},
+		},
+	}
+}
+
+func (r *ReviewSubmissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(reviewSubmissionClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected reviewSubmissionClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r ReviewSubmissionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ReviewSubmissionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items := []ReviewSubmissionItemModel{}
+	if !data.Items.IsUnknown() {
+		resp.Diagnostics.Append(data.Items.ElementsAs(ctx, &items, false)...)
+	}
+
+	for _, item := range items {
+		if item.Type.IsUnknown() || slices.Contains(reviewSubmissionItemTypes, item.Type.ValueString()) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("items"),
+			"Invalid Configuration",
+			fmt.Sprintf("Item `type` must be one of %s, got %q.", strings.Join(reviewSubmissionItemTypes, ", "), item.Type.ValueString()),
+		)
+	}
+}
+
+// populateState maps the submission onto the model. `submitted` records the
+// requested intent rather than Apple's state, so it is left untouched.
+func (r *ReviewSubmissionResource) populateState(ctx context.Context, data *ReviewSubmissionResourceModel, submission *reviews.Submission) diag.Diagnostics {
+	data.ID = types.StringValue(submission.ID)
+	data.AppID = types.StringValue(submission.AppID)
+	data.Platform = types.StringValue(submission.Platform)
+	data.State = types.StringValue(submission.State)
+	data.SubmittedDate = optionalString(submission.SubmittedDate)
+
+	items := make([]ReviewSubmissionItemModel, 0, len(submission.Items))
+	for _, item := range submission.Items {
+		items = append(items, ReviewSubmissionItemModel{
+			Type:       types.StringValue(item.Type),
+			ResourceID: types.StringValue(item.ResourceID),
+		})
+	}
+
+	var diags diag.Diagnostics
+	data.Items, diags = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: reviewSubmissionItemAttrTypes}, items)
+	return diags
+}
+
+func (r *ReviewSubmissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ReviewSubmissionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	submission, err := r.client.CreateReviewSubmission(ctx, reviews.Submission{
+		AppID:    data.AppID.ValueString(),
+		Platform: data.Platform.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create review submission, got error: %s", err))
+		return
+	}
+
+	// Save the submission before adding its items.
+	created := data
+	resp.Diagnostics.Append(r.populateState(ctx, &created, submission)...)
+	resp.Diagnostics.Append(saveCreated(ctx, &resp.State, &created)...)
+
+	items := []ReviewSubmissionItemModel{}
+	resp.Diagnostics.Append(data.Items.ElementsAs(ctx, &items, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, item := range items {
+		_, err := r.client.AddReviewSubmissionItem(ctx, submission.ID, reviews.Item{
+			Type:       item.Type.ValueString(),
+			ResourceID: item.ResourceID.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add %s %q to review submission, got error: %s", item.Type.ValueString(), item.ResourceID.ValueString(), err))
+			return
+		}
+	}
+
+	if data.Submitted.ValueBool() {
+		submission, err = r.client.SubmitReviewSubmission(ctx, submission.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to submit for review, got error: %s", err))
+			return
+		}
+		tflog.Trace(ctx, "submitted for review")
+	}
+
+	submission, err = r.client.GetReviewSubmission(ctx, submission.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read review submission, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a review submission")
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, submission)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReviewSubmissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ReviewSubmissionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	submission, err := r.client.GetReviewSubmission(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read review submission, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, submission)...)
+
+	// Imported submissions have no recorded intent, so infer it from Apple's state.
+	if data.Submitted.IsNull() {
+		data.Submitted = types.BoolValue(submission.State != "READY_FOR_REVIEW")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReviewSubmissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ReviewSubmissionResourceModel
+	var state ReviewSubmissionResourceModel
+
+	// Items force replacement, so only the `submitted` flag can change in place.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	switch {
+	case data.Submitted.ValueBool() && !state.Submitted.ValueBool():
+		_, err = r.client.SubmitReviewSubmission(ctx, data.ID.ValueString())
+		tflog.Trace(ctx, "submitted for review")
+	case !data.Submitted.ValueBool() && state.Submitted.ValueBool():
+		_, err = r.client.CancelReviewSubmission(ctx, data.ID.ValueString())
+		tflog.Trace(ctx, "canceled review submission")
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify review submission, got error: %s", err))
+		return
+	}
+
+	submission, err := r.client.GetReviewSubmission(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read review submission, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, submission)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReviewSubmissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ReviewSubmissionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Review submissions cannot be deleted; cancel any that are still open.
+	if !slices.Contains(reviewSubmissionCancelableStates, data.State.ValueString()) {
+		return
+	}
+
+	_, err := r.client.CancelReviewSubmission(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to cancel review submission, got error: %s", err))
+		return
+	}
+}
+
+func (r *ReviewSubmissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
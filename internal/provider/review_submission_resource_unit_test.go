@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/reviews"
+)
+
+type mockReviewSubmissionClient struct {
+	submission  reviews.Submission
+	submitCalls int
+	cancelCalls int
+}
+
+func (m *mockReviewSubmissionClient) CreateReviewSubmission(ctx context.Context, submission reviews.Submission) (*reviews.Submission, error) {
+	if m.submission.ID != "" && slices.Contains(reviewSubmissionCancelableStates, m.submission.State) {
+		return nil, fmt.Errorf("submission %q is still open", m.submission.ID)
+	}
+	m.submission = submission
+	m.submission.ID = "submission-id"
+	m.submission.State = "READY_FOR_REVIEW"
+	return &m.submission, nil
+}
+
+func (m *mockReviewSubmissionClient) AddReviewSubmissionItem(ctx context.Context, submissionID string, item reviews.Item) (*reviews.Item, error) {
+	m.submission.Items = append(m.submission.Items, item)
+	return &item, nil
+}
+
+func (m *mockReviewSubmissionClient) GetReviewSubmission(ctx context.Context, id string) (*reviews.Submission, error) {
+	return &m.submission, nil
+}
+
+func (m *mockReviewSubmissionClient) SubmitReviewSubmission(ctx context.Context, id string) (*reviews.Submission, error) {
+	m.submitCalls++
+	m.submission.State = "WAITING_FOR_REVIEW"
+	m.submission.SubmittedDate = "2026-10-18T09:00:00Z"
+	return &m.submission, nil
+}
+
+func (m *mockReviewSubmissionClient) CancelReviewSubmission(ctx context.Context, id string) (*reviews.Submission, error) {
+	m.cancelCalls++
+	m.submission.State = "CANCELING"
+	return &m.submission, nil
+}
+
+func reviewSubmissionResourceSchema() schema.Schema {
+	r := &ReviewSubmissionResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func reviewSubmissionVal(s schema.Schema, id interface{}, itemType string, submitted bool, state interface{}) tftypes.Value {
+	itemObjectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"type":        tftypes.String,
+		"resource_id": tftypes.String,
+	}}
+
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.String, id),
+		"app_id":   tftypes.NewValue(tftypes.String, "1234567890"),
+		"platform": tftypes.NewValue(tftypes.String, "IOS"),
+		"items": tftypes.NewValue(tftypes.Set{ElementType: itemObjectType}, []tftypes.Value{
+			tftypes.NewValue(itemObjectType, map[string]tftypes.Value{
+				"type":        tftypes.NewValue(tftypes.String, itemType),
+				"resource_id": tftypes.NewValue(tftypes.String, "version-id"),
+			}),
+		}),
+		"submitted":      tftypes.NewValue(tftypes.Bool, submitted),
+		"state":          tftypes.NewValue(tftypes.String, state),
+		"submitted_date": tftypes.NewValue(tftypes.String, nil),
+	})
+}
+
+func TestReviewSubmissionResource_Create_AddsItemsAndSubmits(t *testing.T) {
+	client := &mockReviewSubmissionClient{}
+	r := &ReviewSubmissionResource{client: client}
+
+	s := reviewSubmissionResourceSchema()
+	planVal := reviewSubmissionVal(s, nil, "APP_STORE_VERSION", true, nil)
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.submission.Items) != 1 || client.submission.Items[0].ResourceID != "version-id" {
+		t.Errorf("expected version 'version-id' to be added to the submission, got %v", client.submission.Items)
+	}
+	if client.submitCalls != 1 {
+		t.Errorf("expected SubmitReviewSubmission to be called once, got %d", client.submitCalls)
+	}
+
+	var data ReviewSubmissionResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.State.ValueString() != "WAITING_FOR_REVIEW" {
+		t.Errorf("expected State 'WAITING_FOR_REVIEW', got %q", data.State.ValueString())
+	}
+	if !data.Submitted.ValueBool() {
+		t.Errorf("expected Submitted to remain true")
+	}
+}
+
+func TestReviewSubmissionResource_Update_CancelsWhenUnsubmitted(t *testing.T) {
+	client := &mockReviewSubmissionClient{
+		submission: reviews.Submission{
+			ID:       "submission-id",
+			AppID:    "1234567890",
+			Platform: "IOS",
+			State:    "WAITING_FOR_REVIEW",
+			Items:    []reviews.Item{{Type: "APP_STORE_VERSION", ResourceID: "version-id"}},
+		},
+	}
+	r := &ReviewSubmissionResource{client: client}
+
+	s := reviewSubmissionResourceSchema()
+	stateVal := reviewSubmissionVal(s, "submission-id", "APP_STORE_VERSION", true, "WAITING_FOR_REVIEW")
+	planVal := reviewSubmissionVal(s, "submission-id", "APP_STORE_VERSION", false, tftypes.UnknownValue)
+
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Update(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if client.cancelCalls != 1 {
+		t.Errorf("expected CancelReviewSubmission to be called once, got %d", client.cancelCalls)
+	}
+	if client.submitCalls != 0 {
+		t.Errorf("expected SubmitReviewSubmission not to be called, got %d", client.submitCalls)
+	}
+}
+
+func TestReviewSubmissionResource_ReplacesItemsOfDraft(t *testing.T) {
+	client := &mockReviewSubmissionClient{
+		submission: reviews.Submission{
+			ID:       "submission-id",
+			AppID:    "1234567890",
+			Platform: "IOS",
+			State:    "READY_FOR_REVIEW",
+			Items:    []reviews.Item{{Type: "APP_STORE_VERSION", ResourceID: "version-id"}},
+		},
+	}
+	r := &ReviewSubmissionResource{client: client}
+
+	s := reviewSubmissionResourceSchema()
+	stateVal := reviewSubmissionVal(s, "submission-id", "APP_STORE_VERSION", false, "READY_FOR_REVIEW")
+
+	// Changing the items replaces the submission: the draft is deleted, then a new one is created.
+	deleteResp := &resource.DeleteResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+	r.Delete(context.Background(), resource.DeleteRequest{State: tfsdk.State{Schema: s, Raw: stateVal}}, deleteResp)
+
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", deleteResp.Diagnostics.Errors()[0].Detail())
+	}
+	if client.cancelCalls != 1 {
+		t.Errorf("expected the draft to be canceled, got %d CancelReviewSubmission calls", client.cancelCalls)
+	}
+
+	planVal := reviewSubmissionVal(s, nil, "APP_EVENT", false, nil)
+	createResp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+	r.Create(context.Background(), resource.CreateRequest{Plan: tfsdk.Plan{Schema: s, Raw: planVal}}, createResp)
+
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", createResp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.submission.Items) != 1 || client.submission.Items[0].Type != "APP_EVENT" {
+		t.Errorf("expected only the new item in the submission, got %v", client.submission.Items)
+	}
+}
+
+func TestReviewSubmissionResource_ValidateConfig_RejectsUnknownItemType(t *testing.T) {
+	r := ReviewSubmissionResource{}
+
+	s := reviewSubmissionResourceSchema()
+	req := resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: s, Raw: reviewSubmissionVal(s, nil, "BUILD", false, nil)},
+	}
+	resp := &resource.ValidateConfigResponse{}
+
+	r.ValidateConfig(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for an unsupported item type")
+	}
+}
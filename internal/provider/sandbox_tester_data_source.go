@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SandboxTesterDataSource{}
+
+func NewSandboxTesterDataSource() datasource.DataSource {
+	return &SandboxTesterDataSource{}
+}
+
+// SandboxTesterDataSource defines the data source implementation.
+type SandboxTesterDataSource struct {
+	client sandboxTesterClient
+}
+
+// SandboxTesterDataSourceModel describes the data source data model.
+type SandboxTesterDataSourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Email                   types.String `tfsdk:"email"`
+	FirstName               types.String `tfsdk:"first_name"`
+	LastName                types.String `tfsdk:"last_name"`
+	Territory               types.String `tfsdk:"territory"`
+	InterruptPurchases      types.Bool   `tfsdk:"interrupt_purchases"`
+	SubscriptionRenewalRate types.String `tfsdk:"subscription_renewal_rate"`
+	ApplePayCompatible      types.Bool   `tfsdk:"apple_pay_compatible"`
+}
+
+func (d *SandboxTesterDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sandbox_tester"
+}
+
+func (d *SandboxTesterDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a sandbox tester by email address.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the sandbox tester.",
+			},
+			"email": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The sandbox tester's Apple Account email address.",
+			},
+			"first_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The sandbox tester's first name.",
+			},
+			"last_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The sandbox tester's last name.",
+			},
+			"territory": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The three-letter code of the App Store territory the tester purchases from.",
+			},
+			"interrupt_purchases": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the tester's purchases are interrupted.",
+			},
+			"subscription_renewal_rate": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "How often a monthly subscription renews in the sandbox.",
+			},
+			"apple_pay_compatible": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the tester can make purchases with Apple Pay.",
+			},
+		},
+	}
+}
+
+func (d *SandboxTesterDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(sandboxTesterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected sandboxTesterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SandboxTesterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SandboxTesterDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tester, err := findSandboxTester(ctx, d.client, "", data.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list sandbox testers, got error: %s", err))
+		return
+	}
+
+	if tester == nil {
+		resp.Diagnostics.AddError(
+			"Sandbox tester not found",
+			fmt.Sprintf("No sandbox tester with email %q was found.", data.Email.ValueString()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(tester.ID)
+	data.FirstName = types.StringValue(tester.FirstName)
+	data.LastName = types.StringValue(tester.LastName)
+	data.Territory = types.StringValue(tester.Territory)
+	data.InterruptPurchases = types.BoolValue(tester.InterruptPurchases)
+	data.SubscriptionRenewalRate = types.StringValue(tester.SubscriptionRenewalRate)
+	data.ApplePayCompatible = types.BoolValue(tester.ApplePayCompatible)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
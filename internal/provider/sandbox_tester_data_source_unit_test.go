@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/sandbox"
+)
+
+func sandboxTesterDataSourceSchema() schema.Schema {
+	d := &SandboxTesterDataSource{}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func sandboxTesterDataSourceVal(s schema.Schema, email string) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                        tftypes.NewValue(tftypes.String, nil),
+		"email":                     tftypes.NewValue(tftypes.String, email),
+		"first_name":                tftypes.NewValue(tftypes.String, nil),
+		"last_name":                 tftypes.NewValue(tftypes.String, nil),
+		"territory":                 tftypes.NewValue(tftypes.String, nil),
+		"interrupt_purchases":       tftypes.NewValue(tftypes.Bool, nil),
+		"subscription_renewal_rate": tftypes.NewValue(tftypes.String, nil),
+		"apple_pay_compatible":      tftypes.NewValue(tftypes.Bool, nil),
+	})
+}
+
+func TestSandboxTesterDataSource_Read_FindsTesterByEmail(t *testing.T) {
+	d := &SandboxTesterDataSource{
+		client: &mockSandboxTesterClient{
+			testers: []sandbox.Tester{
+				{ID: "other-id", AccountName: "other@example.com", Territory: "GBR"},
+				{ID: "tester-id", FirstName: "Jo", LastName: "Bloggs", AccountName: "Sandbox@Example.com", Territory: "USA", InterruptPurchases: true, SubscriptionRenewalRate: "MONTHLY_RENEWAL_EVERY_ONE_HOUR"},
+			},
+		},
+	}
+
+	s := sandboxTesterDataSourceSchema()
+	configVal := sandboxTesterDataSourceVal(s, "sandbox@example.com")
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: s, Raw: configVal},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: configVal},
+	}
+
+	d.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+
+	var data SandboxTesterDataSourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "tester-id" {
+		t.Errorf("expected ID 'tester-id', got %q", data.ID.ValueString())
+	}
+	if data.Territory.ValueString() != "USA" || !data.InterruptPurchases.ValueBool() {
+		t.Errorf("expected an interrupted USA tester, got territory %q and interrupt_purchases %t", data.Territory.ValueString(), data.InterruptPurchases.ValueBool())
+	}
+	if data.Email.ValueString() != "sandbox@example.com" {
+		t.Errorf("expected the configured email to be kept, got %q", data.Email.ValueString())
+	}
+}
+
+func TestSandboxTesterDataSource_Read_NotFound(t *testing.T) {
+	d := &SandboxTesterDataSource{
+		client: &mockSandboxTesterClient{
+			testers: []sandbox.Tester{{ID: "other-id", AccountName: "other@example.com"}},
+		},
+	}
+
+	s := sandboxTesterDataSourceSchema()
+	configVal := sandboxTesterDataSourceVal(s, "sandbox@example.com")
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: s, Raw: configVal},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: configVal},
+	}
+
+	d.Read(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when no sandbox tester matches")
+	}
+}
@@ -0,0 +1,335 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/sandbox"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SandboxTesterResource{}
+var _ resource.ResourceWithImportState = &SandboxTesterResource{}
+var _ resource.ResourceWithValidateConfig = &SandboxTesterResource{}
+
+// sandboxTesterRenewalRates are the accelerated renewal rates Apple offers for sandbox subscriptions.
+var sandboxTesterRenewalRates = []string{
+	"MONTHLY_RENEWAL_EVERY_ONE_HOUR",
+	"MONTHLY_RENEWAL_EVERY_THIRTY_MINUTES",
+	"MONTHLY_RENEWAL_EVERY_FIFTEEN_MINUTES",
+	"MONTHLY_RENEWAL_EVERY_FIVE_MINUTES",
+	"MONTHLY_RENEWAL_EVERY_THREE_MINUTES",
+}
+
+type sandboxTesterClient interface {
+	ListSandboxTesters(ctx context.Context) ([]sandbox.Tester, error)
+	ModifySandboxTester(ctx context.Context, id string, update sandbox.TesterUpdate) (*sandbox.Tester, error)
+	ClearSandboxTesterPurchaseHistory(ctx context.Context, ids []string) error
+}
+
+func NewSandboxTesterResource() resource.Resource {
+	return &SandboxTesterResource{}
+}
+
+// SandboxTesterResource defines the resource implementation.
+type SandboxTesterResource struct {
+	client sandboxTesterClient
+}
+
+// SandboxTesterResourceModel describes the resource data model.
+type SandboxTesterResourceModel struct {
+	ID                          types.String `tfsdk:"id"`
+	Email                       types.String `tfsdk:"email"`
+	FirstName                   types.String `tfsdk:"first_name"`
+	LastName                    types.String `tfsdk:"last_name"`
+	Territory                   types.String `tfsdk:"territory"`
+	InterruptPurchases          types.Bool   `tfsdk:"interrupt_purchases"`
+	SubscriptionRenewalRate     types.String `tfsdk:"subscription_renewal_rate"`
+	ClearPurchaseHistoryVersion types.Int64  `tfsdk:"clear_purchase_history_version"`
+}
+
+func (r *SandboxTesterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sandbox_tester"
+}
+
+func (r *SandboxTesterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the settings of an existing sandbox tester. The App Store Connect API cannot create " +
+			"or delete sandbox testers, so the tester must already exist and is left in place when the resource is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the sandbox tester.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The sandbox tester's Apple Account email address.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"first_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The sandbox tester's first name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The sandbox tester's last name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"territory": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The three-letter code of the App Store territory the tester purchases from, e.g. `GBR`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interrupt_purchases": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether purchases are interrupted so the app must handle an additional step, such as accepting terms.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscription_renewal_rate": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "How often a monthly subscription renews in the sandbox, e.g. `MONTHLY_RENEWAL_EVERY_FIVE_MINUTES`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"clear_purchase_history_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "A version number for the tester's purchase history. Changing it clears every purchase the tester has made.",
+			},
+		},
+	}
+}
+
+func (r *SandboxTesterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(sandboxTesterClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected sandboxTesterClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r SandboxTesterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SandboxTesterResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rate := data.SubscriptionRenewalRate
+	if rate.IsNull() || rate.IsUnknown() {
+		return
+	}
+
+	if !slices.Contains(sandboxTesterRenewalRates, rate.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subscription_renewal_rate"),
+			"Invalid Configuration",
+			fmt.Sprintf("`subscription_renewal_rate` must be one of %s, got %q.", strings.Join(sandboxTesterRenewalRates, ", "), rate.ValueString()),
+		)
+	}
+}
+
+func (r *SandboxTesterResource) populateState(data *SandboxTesterResourceModel, tester *sandbox.Tester) {
+	data.ID = types.StringValue(tester.ID)
+	// Apple Accounts are case-insensitive, so keep the configured spelling.
+	if !strings.EqualFold(data.Email.ValueString(), tester.AccountName) {
+		data.Email = types.StringValue(tester.AccountName)
+	}
+	data.FirstName = types.StringValue(tester.FirstName)
+	data.LastName = types.StringValue(tester.LastName)
+	data.Territory = types.StringValue(tester.Territory)
+	data.InterruptPurchases = types.BoolValue(tester.InterruptPurchases)
+	data.SubscriptionRenewalRate = types.StringValue(tester.SubscriptionRenewalRate)
+}
+
+// update builds the request body from the attributes that are known, leaving
+// anything Terraform doesn't know yet unchanged in App Store Connect.
+func (r *SandboxTesterResource) update(data *SandboxTesterResourceModel) sandbox.TesterUpdate {
+	var update sandbox.TesterUpdate
+	if !data.Territory.IsNull() && !data.Territory.IsUnknown() {
+		update.Territory = data.Territory.ValueStringPointer()
+	}
+	if !data.InterruptPurchases.IsNull() && !data.InterruptPurchases.IsUnknown() {
+		update.InterruptPurchases = data.InterruptPurchases.ValueBoolPointer()
+	}
+	if !data.SubscriptionRenewalRate.IsNull() && !data.SubscriptionRenewalRate.IsUnknown() {
+		update.SubscriptionRenewalRate = data.SubscriptionRenewalRate.ValueStringPointer()
+	}
+	return update
+}
+
+// findSandboxTester looks a tester up by ID, or by email address when the ID is not yet known.
+func findSandboxTester(ctx context.Context, client sandboxTesterClient, id string, email string) (*sandbox.Tester, error) {
+	testers, err := client.ListSandboxTesters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tester := range testers {
+		if id != "" && tester.ID == id {
+			return &tester, nil
+		}
+		if id == "" && strings.EqualFold(tester.AccountName, email) {
+			return &tester, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *SandboxTesterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SandboxTesterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := findSandboxTester(ctx, r.client, "", data.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list sandbox testers, got error: %s", err))
+		return
+	}
+
+	if existing == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"Sandbox tester not found",
+			fmt.Sprintf("No sandbox tester with email %q was found. Sandbox testers must be created in App Store Connect before they can be managed.", data.Email.ValueString()),
+		)
+		return
+	}
+
+	tester, err := r.client.ModifySandboxTester(ctx, existing.ID, r.update(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify sandbox tester, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified a sandbox tester")
+
+	if !data.ClearPurchaseHistoryVersion.IsNull() {
+		err := r.client.ClearSandboxTesterPurchaseHistory(ctx, []string{tester.ID})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear sandbox tester purchase history, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "cleared a sandbox tester's purchase history")
+	}
+
+	r.populateState(&data, tester)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SandboxTesterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SandboxTesterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tester, err := findSandboxTester(ctx, r.client, data.ID.ValueString(), data.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sandbox tester, got error: %s", err))
+		return
+	}
+	if tester == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.populateState(&data, tester)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SandboxTesterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SandboxTesterResourceModel
+	var state SandboxTesterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tester, err := r.client.ModifySandboxTester(ctx, data.ID.ValueString(), r.update(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify sandbox tester, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified a sandbox tester")
+
+	// Removing the version from the configuration should not clear the history again.
+	if !data.ClearPurchaseHistoryVersion.IsNull() && !data.ClearPurchaseHistoryVersion.Equal(state.ClearPurchaseHistoryVersion) {
+		err := r.client.ClearSandboxTesterPurchaseHistory(ctx, []string{tester.ID})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear sandbox tester purchase history, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "cleared a sandbox tester's purchase history")
+	}
+
+	r.populateState(&data, tester)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SandboxTesterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Sandbox testers can only be deleted in App Store Connect, so the tester
+	// is left in place and only removed from state.
+	tflog.Trace(ctx, "removed sandbox tester from state")
+}
+
+func (r *SandboxTesterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !strings.Contains(req.ID, "@") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), req.ID)...)
+}
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/sandbox"
+)
+
+type mockSandboxTesterClient struct {
+	testers []sandbox.Tester
+	updates []sandbox.TesterUpdate
+	cleared []string
+}
+
+func (m *mockSandboxTesterClient) ListSandboxTesters(ctx context.Context) ([]sandbox.Tester, error) {
+	return m.testers, nil
+}
+
+func (m *mockSandboxTesterClient) ModifySandboxTester(ctx context.Context, id string, update sandbox.TesterUpdate) (*sandbox.Tester, error) {
+	m.updates = append(m.updates, update)
+
+	tester := sandbox.Tester{ID: id, AccountName: "sandbox@example.com", Territory: "USA", SubscriptionRenewalRate: "MONTHLY_RENEWAL_EVERY_ONE_HOUR"}
+	if update.Territory != nil {
+		tester.Territory = *update.Territory
+	}
+	if update.InterruptPurchases != nil {
+		tester.InterruptPurchases = *update.InterruptPurchases
+	}
+	if update.SubscriptionRenewalRate != nil {
+		tester.SubscriptionRenewalRate = *update.SubscriptionRenewalRate
+	}
+	return &tester, nil
+}
+
+func (m *mockSandboxTesterClient) ClearSandboxTesterPurchaseHistory(ctx context.Context, ids []string) error {
+	m.cleared = append(m.cleared, ids...)
+	return nil
+}
+
+func sandboxTesterResourceSchema() schema.Schema {
+	r := &SandboxTesterResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func sandboxTesterVal(s schema.Schema, id interface{}, territory interface{}, renewalRate interface{}, clearVersion interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                             tftypes.NewValue(tftypes.String, id),
+		"email":                          tftypes.NewValue(tftypes.String, "sandbox@example.com"),
+		"first_name":                     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"last_name":                      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"territory":                      tftypes.NewValue(tftypes.String, territory),
+		"interrupt_purchases":            tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+		"subscription_renewal_rate":      tftypes.NewValue(tftypes.String, renewalRate),
+		"clear_purchase_history_version": tftypes.NewValue(tftypes.Number, clearVersion),
+	})
+}
+
+func TestSandboxTesterResource_Create_OnlySendsKnownSettings(t *testing.T) {
+	client := &mockSandboxTesterClient{
+		testers: []sandbox.Tester{{ID: "tester-id", AccountName: "Sandbox@example.com"}},
+	}
+	r := &SandboxTesterResource{client: client}
+
+	s := sandboxTesterResourceSchema()
+	planVal := sandboxTesterVal(s, tftypes.UnknownValue, "GBR", tftypes.UnknownValue, nil)
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+
+	update := client.updates[0]
+	if update.Territory == nil || *update.Territory != "GBR" {
+		t.Errorf("expected territory 'GBR' to be sent, got %v", update.Territory)
+	}
+	if update.InterruptPurchases != nil || update.SubscriptionRenewalRate != nil {
+		t.Errorf("expected unconfigured settings not to be sent, got %+v", update)
+	}
+	if len(client.cleared) != 0 {
+		t.Errorf("expected purchase history not to be cleared, got %v", client.cleared)
+	}
+
+	var data SandboxTesterResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "tester-id" {
+		t.Errorf("expected ID 'tester-id', got %q", data.ID.ValueString())
+	}
+	if data.SubscriptionRenewalRate.ValueString() != "MONTHLY_RENEWAL_EVERY_ONE_HOUR" {
+		t.Errorf("expected the current renewal rate in state, got %q", data.SubscriptionRenewalRate.ValueString())
+	}
+}
+
+func TestSandboxTesterResource_Create_NotFound(t *testing.T) {
+	r := &SandboxTesterResource{client: &mockSandboxTesterClient{}}
+
+	s := sandboxTesterResourceSchema()
+	planVal := sandboxTesterVal(s, tftypes.UnknownValue, "GBR", tftypes.UnknownValue, nil)
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when the sandbox tester does not exist")
+	}
+}
+
+func TestSandboxTesterResource_Update_ClearsPurchaseHistoryWhenVersionChanges(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		stateVersion  interface{}
+		planVersion   interface{}
+		expectCleared bool
+	}{
+		{name: "unchanged", stateVersion: 1, planVersion: 1},
+		{name: "incremented", stateVersion: 1, planVersion: 2, expectCleared: true},
+		{name: "removed", stateVersion: 1, planVersion: nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &mockSandboxTesterClient{}
+			r := &SandboxTesterResource{client: client}
+
+			s := sandboxTesterResourceSchema()
+			stateVal := sandboxTesterVal(s, "tester-id", "GBR", "MONTHLY_RENEWAL_EVERY_ONE_HOUR", tc.stateVersion)
+			planVal := sandboxTesterVal(s, "tester-id", "GBR", "MONTHLY_RENEWAL_EVERY_ONE_HOUR", tc.planVersion)
+
+			req := resource.UpdateRequest{
+				Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+				State: tfsdk.State{Schema: s, Raw: stateVal},
+			}
+			resp := &resource.UpdateResponse{
+				State: tfsdk.State{Schema: s, Raw: stateVal},
+			}
+
+			r.Update(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+			}
+			if (len(client.cleared) > 0) != tc.expectCleared {
+				t.Errorf("expected cleared=%t, got %v", tc.expectCleared, client.cleared)
+			}
+		})
+	}
+}
+
+func TestSandboxTesterResource_ValidateConfig_RejectsUnknownRenewalRate(t *testing.T) {
+	r := SandboxTesterResource{}
+
+	s := sandboxTesterResourceSchema()
+	req := resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: s, Raw: sandboxTesterVal(s, nil, nil, "DAILY", nil)},
+	}
+	resp := &resource.ValidateConfigResponse{}
+
+	r.ValidateConfig(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for an unsupported renewal rate")
+	}
+}
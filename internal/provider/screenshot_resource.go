@@ -0,0 +1,313 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/screenshots"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ScreenshotResource{}
+var _ resource.ResourceWithValidateConfig = &ScreenshotResource{}
+var _ resource.ResourceWithModifyPlan = &ScreenshotResource{}
+
+type screenshotClient interface {
+	ReserveScreenshot(ctx context.Context, setID string, fileName string, fileSize int64) (*screenshots.Screenshot, error)
+	CommitScreenshot(ctx context.Context, id string, checksum string) (*screenshots.Screenshot, error)
+	GetScreenshot(ctx context.Context, id string) (*screenshots.Screenshot, error)
+	DeleteScreenshot(ctx context.Context, id string) error
+	ListScreenshotIDs(ctx context.Context, setID string) ([]string, error)
+	ReorderScreenshots(ctx context.Context, setID string, ids []string) error
+}
+
+// screenshotOrder is shared by every screenshot resource so that reordering a
+// set is serialised across the resources Terraform creates in parallel.
+var screenshotOrder = newAssetOrder()
+
+func NewScreenshotResource() resource.Resource {
+	return &ScreenshotResource{}
+}
+
+// ScreenshotResource defines the resource implementation.
+type ScreenshotResource struct {
+	client screenshotClient
+}
+
+// ScreenshotResourceModel describes the resource data model.
+type ScreenshotResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	ScreenshotSetID    types.String `tfsdk:"screenshot_set_id"`
+	FilePath           types.String `tfsdk:"file_path"`
+	Position           types.Int64  `tfsdk:"position"`
+	FileName           types.String `tfsdk:"file_name"`
+	SourceFileChecksum types.String `tfsdk:"source_file_checksum"`
+	AssetDeliveryState types.String `tfsdk:"asset_delivery_state"`
+}
+
+func (r *ScreenshotResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_screenshot"
+}
+
+func (r *ScreenshotResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uploads a screenshot from a local file to an App Store screenshot set. The screenshot is replaced whenever the MD5 checksum of the local file differs from the checksum stored by Apple.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the screenshot.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"screenshot_set_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the screenshot set to upload the screenshot to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The path to the image file to upload.",
+			},
+			"position": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The 1-based position of the screenshot within its set. When omitted, the screenshot is left where Apple places it.",
+			},
+			"file_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The file name Apple recorded for the upload.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_file_checksum": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The MD5 checksum of the uploaded file, as stored by Apple.",
+			},
+			"asset_delivery_state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The processing state of the uploaded asset (e.g. `UPLOAD_COMPLETE`, `COMPLETE`, `FAILED`).",
+			},
+		},
+	}
+}
+
+func (r *ScreenshotResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(screenshotClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected screenshotClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r ScreenshotResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ScreenshotResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Position.IsNull() && !data.Position.IsUnknown() && data.Position.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("position"),
+			"Invalid Configuration",
+			"`position` is 1-based and must be at least 1.",
+		)
+	}
+}
+
+func (r ScreenshotResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ScreenshotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.FilePath.IsUnknown() {
+		return
+	}
+
+	_, checksum, err := readAsset(plan.FilePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file_path"),
+			"Unable to read screenshot",
+			fmt.Sprintf("Unable to read %q, got error: %s", plan.FilePath.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_file_checksum"), checksum)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state ScreenshotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	// Uploaded assets cannot be modified, so a different file means a new screenshot.
+	if state.SourceFileChecksum.ValueString() != checksum {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("source_file_checksum"))
+	}
+}
+
+func (r *ScreenshotResource) populateState(data *ScreenshotResourceModel, screenshot *screenshots.Screenshot) {
+	data.ID = types.StringValue(screenshot.ID)
+	data.FileName = types.StringValue(screenshot.FileName)
+	data.SourceFileChecksum = types.StringValue(screenshot.SourceFileChecksum)
+	data.AssetDeliveryState = types.StringValue(screenshot.AssetDeliveryState)
+}
+
+func (r *ScreenshotResource) reorder(ctx context.Context, data *ScreenshotResourceModel) error {
+	if data.Position.IsNull() {
+		return nil
+	}
+
+	return screenshotOrder.reorder(
+		ctx,
+		data.ScreenshotSetID.ValueString(),
+		data.ID.ValueString(),
+		data.Position.ValueInt64(),
+		r.client.ListScreenshotIDs,
+		r.client.ReorderScreenshots,
+	)
+}
+
+func (r *ScreenshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ScreenshotResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	file, checksum, err := readAsset(data.FilePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("File Error", fmt.Sprintf("Unable to read screenshot, got error: %s", err))
+		return
+	}
+
+	reservation, err := r.client.ReserveScreenshot(ctx, data.ScreenshotSetID.ValueString(), filepath.Base(data.FilePath.ValueString()), int64(len(file)))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reserve screenshot, got error: %s", err))
+		return
+	}
+
+	err = uploadAsset(ctx, reservation.UploadOperations, file)
+	if err != nil {
+		resp.Diagnostics.AddError("Upload Error", fmt.Sprintf("Unable to upload screenshot, got error: %s", err))
+		return
+	}
+
+	screenshot, err := r.client.CommitScreenshot(ctx, reservation.ID, checksum)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to commit screenshot, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "uploaded a screenshot")
+
+	r.populateState(&data, screenshot)
+
+	err = r.reorder(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reorder screenshots, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScreenshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ScreenshotResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	screenshot, err := r.client.GetScreenshot(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read screenshot, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, screenshot)
+
+	if !data.Position.IsNull() {
+		ids, err := r.client.ListScreenshotIDs(ctx, data.ScreenshotSetID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read screenshot order, got error: %s", err))
+			return
+		}
+		data.Position = types.Int64Value(positionOf(ids, data.ID.ValueString()))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScreenshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ScreenshotResourceModel
+
+	// A changed file forces replacement, so only the position can be updated in place.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.reorder(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reorder screenshots, got error: %s", err))
+		return
+	}
+
+	screenshot, err := r.client.GetScreenshot(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read screenshot, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, screenshot)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScreenshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ScreenshotResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteScreenshot(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete screenshot, got error: %s", err))
+		return
+	}
+}
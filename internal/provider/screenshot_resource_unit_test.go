@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/screenshots"
+)
+
+type mockScreenshotClient struct {
+	reserveFn   func(ctx context.Context, setID string, fileName string, fileSize int64) (*screenshots.Screenshot, error)
+	commitFn    func(ctx context.Context, id string, checksum string) (*screenshots.Screenshot, error)
+	getFn       func(ctx context.Context, id string) (*screenshots.Screenshot, error)
+	ids         []string
+	reorderedTo []string
+}
+
+func (m *mockScreenshotClient) ReserveScreenshot(ctx context.Context, setID string, fileName string, fileSize int64) (*screenshots.Screenshot, error) {
+	if m.reserveFn != nil {
+		return m.reserveFn(ctx, setID, fileName, fileSize)
+	}
+	return &screenshots.Screenshot{}, nil
+}
+
+func (m *mockScreenshotClient) CommitScreenshot(ctx context.Context, id string, checksum string) (*screenshots.Screenshot, error) {
+	if m.commitFn != nil {
+		return m.commitFn(ctx, id, checksum)
+	}
+	return &screenshots.Screenshot{ID: id}, nil
+}
+
+func (m *mockScreenshotClient) GetScreenshot(ctx context.Context, id string) (*screenshots.Screenshot, error) {
+	if m.getFn != nil {
+		return m.getFn(ctx, id)
+	}
+	return &screenshots.Screenshot{ID: id}, nil
+}
+
+func (m *mockScreenshotClient) DeleteScreenshot(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *mockScreenshotClient) ListScreenshotIDs(ctx context.Context, setID string) ([]string, error) {
+	return m.ids, nil
+}
+
+func (m *mockScreenshotClient) ReorderScreenshots(ctx context.Context, setID string, ids []string) error {
+	m.reorderedTo = ids
+	return nil
+}
+
+func screenshotResourceSchema() schema.Schema {
+	r := &ScreenshotResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func writeScreenshotFile(t *testing.T, contents string) string {
+	t.Helper()
+	filePath := filepath.Join(t.TempDir(), "home.png")
+	if err := os.WriteFile(filePath, []byte(contents), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return filePath
+}
+
+func screenshotVal(s schema.Schema, id interface{}, filePath string, position interface{}, checksum interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                   tftypes.NewValue(tftypes.String, id),
+		"screenshot_set_id":    tftypes.NewValue(tftypes.String, "set-id"),
+		"file_path":            tftypes.NewValue(tftypes.String, filePath),
+		"position":             tftypes.NewValue(tftypes.Number, position),
+		"file_name":            tftypes.NewValue(tftypes.String, nil),
+		"source_file_checksum": tftypes.NewValue(tftypes.String, checksum),
+		"asset_delivery_state": tftypes.NewValue(tftypes.String, nil),
+	})
+}
+
+func TestScreenshotResource_Create_ReservesUploadsAndCommits(t *testing.T) {
+	filePath := writeScreenshotFile(t, "hello world")
+
+	var reservedName string
+	var reservedSize int64
+	var committedChecksum string
+
+	client := &mockScreenshotClient{
+		reserveFn: func(ctx context.Context, setID string, fileName string, fileSize int64) (*screenshots.Screenshot, error) {
+			reservedName = fileName
+			reservedSize = fileSize
+			return &screenshots.Screenshot{ID: "screenshot-id"}, nil
+		},
+		commitFn: func(ctx context.Context, id string, checksum string) (*screenshots.Screenshot, error) {
+			committedChecksum = checksum
+			return &screenshots.Screenshot{
+				ID:                 id,
+				FileName:           "home.png",
+				SourceFileChecksum: checksum,
+				AssetDeliveryState: "UPLOAD_COMPLETE",
+			}, nil
+		},
+		ids: []string{"existing-id", "screenshot-id"},
+	}
+	r := &ScreenshotResource{client: client}
+
+	s := screenshotResourceSchema()
+	planVal := screenshotVal(s, nil, filePath, 1, "5eb63bbbe01eeed093cb22bb8f5acdc3")
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if reservedName != "home.png" || reservedSize != 11 {
+		t.Errorf("expected reservation for 'home.png' of 11 bytes, got %q of %d bytes", reservedName, reservedSize)
+	}
+	if committedChecksum != "5eb63bbbe01eeed093cb22bb8f5acdc3" {
+		t.Errorf("expected commit with the file's MD5 checksum, got %q", committedChecksum)
+	}
+	if !reflect.DeepEqual(client.reorderedTo, []string{"screenshot-id", "existing-id"}) {
+		t.Errorf("expected screenshot to be moved to the first position, got %v", client.reorderedTo)
+	}
+
+	var data ScreenshotResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "screenshot-id" {
+		t.Errorf("expected ID 'screenshot-id', got %q", data.ID.ValueString())
+	}
+	if data.AssetDeliveryState.ValueString() != "UPLOAD_COMPLETE" {
+		t.Errorf("expected AssetDeliveryState 'UPLOAD_COMPLETE', got %q", data.AssetDeliveryState.ValueString())
+	}
+}
+
+func TestScreenshotResource_ModifyPlan_RequiresReplaceWhenChecksumDiffers(t *testing.T) {
+	filePath := writeScreenshotFile(t, "hello world")
+
+	r := ScreenshotResource{client: &mockScreenshotClient{}}
+
+	s := screenshotResourceSchema()
+	stateVal := screenshotVal(s, "screenshot-id", filePath, nil, "checksum-from-apple")
+	planVal := screenshotVal(s, "screenshot-id", filePath, nil, tftypes.UnknownValue)
+
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.ModifyPlanResponse{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+
+	r.ModifyPlan(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(resp.RequiresReplace) != 1 || !resp.RequiresReplace[0].Equal(path.Root("source_file_checksum")) {
+		t.Errorf("expected replacement on source_file_checksum, got %v", resp.RequiresReplace)
+	}
+
+	var plan ScreenshotResourceModel
+	resp.Plan.Get(context.Background(), &plan)
+
+	if plan.SourceFileChecksum.ValueString() != "5eb63bbbe01eeed093cb22bb8f5acdc3" {
+		t.Errorf("expected planned checksum to match the local file, got %q", plan.SourceFileChecksum.ValueString())
+	}
+}
+
+func TestScreenshotResource_ModifyPlan_NoReplaceWhenChecksumMatches(t *testing.T) {
+	filePath := writeScreenshotFile(t, "hello world")
+
+	r := ScreenshotResource{client: &mockScreenshotClient{}}
+
+	s := screenshotResourceSchema()
+	stateVal := screenshotVal(s, "screenshot-id", filePath, nil, "5eb63bbbe01eeed093cb22bb8f5acdc3")
+
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+		Plan:  tfsdk.Plan{Schema: s, Raw: stateVal},
+	}
+	resp := &resource.ModifyPlanResponse{
+		Plan: tfsdk.Plan{Schema: s, Raw: stateVal},
+	}
+
+	r.ModifyPlan(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(resp.RequiresReplace) != 0 {
+		t.Errorf("expected no replacement, got %v", resp.RequiresReplace)
+	}
+}
+
+func TestScreenshotResource_Read_ReportsCurrentPosition(t *testing.T) {
+	r := &ScreenshotResource{
+		client: &mockScreenshotClient{
+			getFn: func(ctx context.Context, id string) (*screenshots.Screenshot, error) {
+				return &screenshots.Screenshot{ID: id, FileName: "home.png", SourceFileChecksum: "checksum", AssetDeliveryState: "COMPLETE"}, nil
+			},
+			ids: []string{"other-id", "screenshot-id"},
+		},
+	}
+
+	s := screenshotResourceSchema()
+	stateVal := screenshotVal(s, "screenshot-id", "home.png", 1, "checksum")
+
+	req := resource.ReadRequest{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+	resp := &resource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+
+	var data ScreenshotResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.Position.ValueInt64() != 2 {
+		t.Errorf("expected Position 2 after the screenshot was moved, got %d", data.Position.ValueInt64())
+	}
+}
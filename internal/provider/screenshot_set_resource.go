@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/screenshots"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ScreenshotSetResource{}
+var _ resource.ResourceWithImportState = &ScreenshotSetResource{}
+var _ resource.ResourceWithValidateConfig = &ScreenshotSetResource{}
+
+type screenshotSetClient interface {
+	CreateScreenshotSet(ctx context.Context, set screenshots.ScreenshotSet) (*screenshots.ScreenshotSet, error)
+	GetScreenshotSet(ctx context.Context, id string) (*screenshots.ScreenshotSet, error)
+	DeleteScreenshotSet(ctx context.Context, id string) error
+}
+
+func NewScreenshotSetResource() resource.Resource {
+	return &ScreenshotSetResource{}
+}
+
+// ScreenshotSetResource defines the resource implementation.
+type ScreenshotSetResource struct {
+	client screenshotSetClient
+}
+
+// ScreenshotSetResourceModel describes the resource data model.
+type ScreenshotSetResourceModel struct {
+	ID                              types.String `tfsdk:"id"`
+	LocalizationID                  types.String `tfsdk:"localization_id"`
+	CustomProductPageLocalizationID types.String `tfsdk:"custom_product_page_localization_id"`
+	TreatmentLocalizationID         types.String `tfsdk:"experiment_treatment_localization_id"`
+	DisplayType                     types.String `tfsdk:"display_type"`
+}
+
+func (r *ScreenshotSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_screenshot_set"
+}
+
+func (r *ScreenshotSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a set of App Store screenshots for one device size within an App Store version localization, " +
+			"a custom product page localization or a product page optimization treatment localization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the screenshot set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"localization_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The identifier of the App Store version localization the screenshots belong to. Exactly one of `localization_id`, `custom_product_page_localization_id` or `experiment_treatment_localization_id` must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_product_page_localization_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The identifier of the custom product page localization the screenshots belong to, from the page's `localization_ids`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"experiment_treatment_localization_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The identifier of the experiment treatment localization the screenshots belong to, from the treatment's `localization_ids`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The device size the screenshots are for (e.g. `APP_IPHONE_67`, `APP_IPAD_PRO_3GEN_129`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ScreenshotSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(screenshotSetClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected screenshotSetClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r ScreenshotSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ScreenshotSetResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parents := 0
+	for _, id := range []types.String{data.LocalizationID, data.CustomProductPageLocalizationID, data.TreatmentLocalizationID} {
+		if id.IsUnknown() {
+			return
+		}
+		if !id.IsNull() {
+			parents++
+		}
+	}
+
+	if parents != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("localization_id"),
+			"Invalid Configuration",
+			"Exactly one of `localization_id`, `custom_product_page_localization_id` or `experiment_treatment_localization_id` must be set.",
+		)
+	}
+}
+
+func (r *ScreenshotSetResource) populateState(data *ScreenshotSetResourceModel, set *screenshots.ScreenshotSet) {
+	data.ID = types.StringValue(set.ID)
+	data.LocalizationID = optionalString(set.LocalizationID)
+	data.CustomProductPageLocalizationID = optionalString(set.CustomProductPageLocalizationID)
+	data.TreatmentLocalizationID = optionalString(set.TreatmentLocalizationID)
+	data.DisplayType = types.StringValue(set.DisplayType)
+}
+
+func (r *ScreenshotSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ScreenshotSetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	set, err := r.client.CreateScreenshotSet(ctx, screenshots.ScreenshotSet{
+		LocalizationID:                  data.LocalizationID.ValueString(),
+		CustomProductPageLocalizationID: data.CustomProductPageLocalizationID.ValueString(),
+		TreatmentLocalizationID:         data.TreatmentLocalizationID.ValueString(),
+		DisplayType:                     data.DisplayType.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create screenshot set, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a screenshot set")
+
+	r.populateState(&data, set)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScreenshotSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ScreenshotSetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	set, err := r.client.GetScreenshotSet(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read screenshot set, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, set)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScreenshotSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ScreenshotSetResourceModel
+
+	// Every configurable attribute forces replacement, so there is nothing to send.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScreenshotSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ScreenshotSetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteScreenshotSet(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete screenshot set, got error: %s", err))
+		return
+	}
+}
+
+func (r *ScreenshotSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
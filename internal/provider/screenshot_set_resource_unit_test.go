@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/screenshots"
+)
+
+type mockScreenshotSetClient struct {
+	createFn   func(ctx context.Context, set screenshots.ScreenshotSet) (*screenshots.ScreenshotSet, error)
+	deletedIDs []string
+}
+
+func (m *mockScreenshotSetClient) CreateScreenshotSet(ctx context.Context, set screenshots.ScreenshotSet) (*screenshots.ScreenshotSet, error) {
+	if m.createFn != nil {
+		return m.createFn(ctx, set)
+	}
+	return &screenshots.ScreenshotSet{}, nil
+}
+
+func (m *mockScreenshotSetClient) GetScreenshotSet(ctx context.Context, id string) (*screenshots.ScreenshotSet, error) {
+	return &screenshots.ScreenshotSet{ID: id}, nil
+}
+
+func (m *mockScreenshotSetClient) DeleteScreenshotSet(ctx context.Context, id string) error {
+	m.deletedIDs = append(m.deletedIDs, id)
+	return nil
+}
+
+func screenshotSetResourceSchema() schema.Schema {
+	r := &ScreenshotSetResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func screenshotSetVal(s schema.Schema, id interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                                   tftypes.NewValue(tftypes.String, id),
+		"localization_id":                      tftypes.NewValue(tftypes.String, "localization-id"),
+		"custom_product_page_localization_id":  tftypes.NewValue(tftypes.String, nil),
+		"experiment_treatment_localization_id": tftypes.NewValue(tftypes.String, nil),
+		"display_type":                         tftypes.NewValue(tftypes.String, "APP_IPHONE_67"),
+	})
+}
+
+func TestScreenshotSetResource_Create_SetsStateCorrectly(t *testing.T) {
+	r := &ScreenshotSetResource{
+		client: &mockScreenshotSetClient{
+			createFn: func(ctx context.Context, set screenshots.ScreenshotSet) (*screenshots.ScreenshotSet, error) {
+				set.ID = "set-id"
+				return &set, nil
+			},
+		},
+	}
+
+	s := screenshotSetResourceSchema()
+	planVal := screenshotSetVal(s, nil)
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+
+	var data ScreenshotSetResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "set-id" {
+		t.Errorf("expected ID 'set-id', got %q", data.ID.ValueString())
+	}
+	if data.DisplayType.ValueString() != "APP_IPHONE_67" {
+		t.Errorf("expected DisplayType 'APP_IPHONE_67', got %q", data.DisplayType.ValueString())
+	}
+}
+
+func TestScreenshotSetResource_Delete_DeletesSet(t *testing.T) {
+	client := &mockScreenshotSetClient{}
+	r := &ScreenshotSetResource{client: client}
+
+	s := screenshotSetResourceSchema()
+	req := resource.DeleteRequest{
+		State: tfsdk.State{Schema: s, Raw: screenshotSetVal(s, "set-id")},
+	}
+	resp := &resource.DeleteResponse{}
+
+	r.Delete(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.deletedIDs) != 1 || client.deletedIDs[0] != "set-id" {
+		t.Errorf("expected DeleteScreenshotSet called with 'set-id', got %v", client.deletedIDs)
+	}
+}
+
+func TestScreenshotSetResource_ValidateConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name                            string
+		localizationID                  interface{}
+		customProductPageLocalizationID interface{}
+		treatmentLocalizationID         interface{}
+		expectError                     bool
+	}{
+		{name: "version localization", localizationID: "localization-id"},
+		{name: "custom product page localization", customProductPageLocalizationID: "page-localization-id"},
+		{name: "experiment treatment localization", treatmentLocalizationID: "treatment-localization-id"},
+		{name: "none", expectError: true},
+		{name: "two", localizationID: "localization-id", customProductPageLocalizationID: "page-localization-id", expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := ScreenshotSetResource{}
+
+			s := screenshotSetResourceSchema()
+			configVal := tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+				"id":                                   tftypes.NewValue(tftypes.String, nil),
+				"localization_id":                      tftypes.NewValue(tftypes.String, tc.localizationID),
+				"custom_product_page_localization_id":  tftypes.NewValue(tftypes.String, tc.customProductPageLocalizationID),
+				"experiment_treatment_localization_id": tftypes.NewValue(tftypes.String, tc.treatmentLocalizationID),
+				"display_type":                         tftypes.NewValue(tftypes.String, "APP_IPHONE_67"),
+			})
+
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: configVal},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error=%t, got diagnostics %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
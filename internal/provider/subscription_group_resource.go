@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/subscriptions"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SubscriptionGroupResource{}
+var _ resource.ResourceWithImportState = &SubscriptionGroupResource{}
+var _ resource.ResourceWithValidateConfig = &SubscriptionGroupResource{}
+
+type subscriptionGroupClient interface {
+	CreateSubscriptionGroup(ctx context.Context, group subscriptions.Group) (*subscriptions.Group, error)
+	GetSubscriptionGroup(ctx context.Context, id string) (*subscriptions.Group, error)
+	ModifySubscriptionGroup(ctx context.Context, id string, group subscriptions.Group) (*subscriptions.Group, error)
+	DeleteSubscriptionGroup(ctx context.Context, id string) error
+	ListSubscriptionGroupLocalizations(ctx context.Context, groupID string) ([]subscriptions.GroupLocalization, error)
+	CreateSubscriptionGroupLocalization(ctx context.Context, localization subscriptions.GroupLocalization) (*subscriptions.GroupLocalization, error)
+	ModifySubscriptionGroupLocalization(ctx context.Context, id string, localization subscriptions.GroupLocalization) (*subscriptions.GroupLocalization, error)
+	DeleteSubscriptionGroupLocalization(ctx context.Context, id string) error
+}
+
+func NewSubscriptionGroupResource() resource.Resource {
+	return &SubscriptionGroupResource{}
+}
+
+// SubscriptionGroupResource defines the resource implementation.
+type SubscriptionGroupResource struct {
+	client subscriptionGroupClient
+}
+
+// SubscriptionGroupResourceModel describes the resource data model.
+type SubscriptionGroupResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	AppID         types.String `tfsdk:"app_id"`
+	ReferenceName types.String `tfsdk:"reference_name"`
+	Localizations types.Set    `tfsdk:"localization"`
+}
+
+// SubscriptionGroupLocalizationModel describes a `localization` block.
+type SubscriptionGroupLocalizationModel struct {
+	Locale        types.String `tfsdk:"locale"`
+	Name          types.String `tfsdk:"name"`
+	CustomAppName types.String `tfsdk:"custom_app_name"`
+}
+
+var subscriptionGroupLocalizationAttrTypes = map[string]attr.Type{
+	"locale":          types.StringType,
+	"name":            types.StringType,
+	"custom_app_name": types.StringType,
+}
+
+func (r *SubscriptionGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription_group"
+}
+
+func (r *SubscriptionGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a group of auto-renewable subscriptions. Customers can only subscribe to one subscription in a group at a time.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the subscription group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the app the subscription group belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reference_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name used for the group in App Store Connect.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"localization": schema.SetNestedBlock{
+				MarkdownDescription: "The name of the subscription group shown on the App Store in a locale.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"locale": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The locale of the localization, e.g. `en-GB`.",
+						},
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The display name of the group shown to customers.",
+						},
+						"custom_app_name": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "An app name shown in subscription management in place of the app's name.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *SubscriptionGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(subscriptionGroupClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected subscriptionGroupClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r SubscriptionGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SubscriptionGroupResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Localizations.IsNull() || data.Localizations.IsUnknown() {
+		return
+	}
+
+	var localizations []SubscriptionGroupLocalizationModel
+	resp.Diagnostics.Append(data.Localizations.ElementsAs(ctx, &localizations, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, localization := range localizations {
+		if localization.Locale.IsUnknown() {
+			continue
+		}
+		locale := localization.Locale.ValueString()
+		if seen[locale] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("localization"),
+				"Invalid Configuration",
+				fmt.Sprintf("Locale %q is configured more than once.", locale),
+			)
+		}
+		seen[locale] = true
+	}
+}
+
+func (r *SubscriptionGroupResource) populateState(ctx context.Context, data *SubscriptionGroupResourceModel, group *subscriptions.Group, localizations []subscriptions.GroupLocalization) diag.Diagnostics {
+	data.ID = types.StringValue(group.ID)
+	data.AppID = types.StringValue(group.AppID)
+	data.ReferenceName = types.StringValue(group.ReferenceName)
+
+	models := []SubscriptionGroupLocalizationModel{}
+	for _, localization := range localizations {
+		models = append(models, SubscriptionGroupLocalizationModel{
+			Locale:        types.StringValue(localization.Locale),
+			Name:          types.StringValue(localization.Name),
+			CustomAppName: optionalString(localization.CustomAppName),
+		})
+	}
+
+	var diags diag.Diagnostics
+	data.Localizations, diags = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: subscriptionGroupLocalizationAttrTypes}, models)
+	return diags
+}
+
+// syncLocalizations creates, modifies and deletes localizations, matched by
+// locale, and records the result, along with the group, in data.
+func (r *SubscriptionGroupResource) syncLocalizations(ctx context.Context, data *SubscriptionGroupResourceModel, group *subscriptions.Group) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var planned []SubscriptionGroupLocalizationModel
+	diags.Append(data.Localizations.ElementsAs(ctx, &planned, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	existing, err := r.client.ListSubscriptionGroupLocalizations(ctx, group.ID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list subscription group localizations, got error: %s", err))
+		return diags
+	}
+
+	current := map[string]subscriptions.GroupLocalization{}
+	for _, localization := range existing {
+		current[localization.Locale] = localization
+	}
+
+	for _, model := range planned {
+		localization := subscriptions.GroupLocalization{
+			GroupID:       group.ID,
+			Locale:        model.Locale.ValueString(),
+			Name:          model.Name.ValueString(),
+			CustomAppName: model.CustomAppName.ValueString(),
+		}
+
+		match, ok := current[localization.Locale]
+		delete(current, localization.Locale)
+
+		switch {
+		case !ok:
+			_, err = r.client.CreateSubscriptionGroupLocalization(ctx, localization)
+		case match.Name != localization.Name || match.CustomAppName != localization.CustomAppName:
+			_, err = r.client.ModifySubscriptionGroupLocalization(ctx, match.ID, localization)
+		}
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update %s localization, got error: %s", localization.Locale, err))
+			return diags
+		}
+	}
+
+	for locale, localization := range current {
+		if err := r.client.DeleteSubscriptionGroupLocalization(ctx, localization.ID); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete %s localization, got error: %s", locale, err))
+			return diags
+		}
+	}
+
+	existing, err = r.client.ListSubscriptionGroupLocalizations(ctx, group.ID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list subscription group localizations, got error: %s", err))
+		return diags
+	}
+
+	diags.Append(r.populateState(ctx, data, group, existing)...)
+	return diags
+}
+
+func (r *SubscriptionGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SubscriptionGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.CreateSubscriptionGroup(ctx, subscriptions.Group{
+		AppID:         data.AppID.ValueString(),
+		ReferenceName: data.ReferenceName.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create subscription group, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a subscription group")
+
+	// Save the group before adding its localizations.
+	created := data
+	resp.Diagnostics.Append(r.populateState(ctx, &created, group, nil)...)
+	resp.Diagnostics.Append(saveCreated(ctx, &resp.State, &created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncLocalizations(ctx, &data, group)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SubscriptionGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.GetSubscriptionGroup(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read subscription group, got error: %s", err))
+		return
+	}
+
+	localizations, err := r.client.ListSubscriptionGroupLocalizations(ctx, group.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list subscription group localizations, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, group, localizations)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SubscriptionGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.ModifySubscriptionGroup(ctx, data.ID.ValueString(), subscriptions.Group{
+		AppID:         data.AppID.ValueString(),
+		ReferenceName: data.ReferenceName.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify subscription group, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified a subscription group")
+
+	resp.Diagnostics.Append(r.syncLocalizations(ctx, &data, group)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SubscriptionGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSubscriptionGroup(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete subscription group, got error: %s", err))
+		return
+	}
+}
+
+func (r *SubscriptionGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/subscriptions"
+)
+
+type mockSubscriptionGroupClient struct {
+	localizations []subscriptions.GroupLocalization
+	createErr     error
+	created       []string
+	modified      []string
+	deleted       []string
+}
+
+func (m *mockSubscriptionGroupClient) CreateSubscriptionGroup(ctx context.Context, group subscriptions.Group) (*subscriptions.Group, error) {
+	group.ID = "group-id"
+	return &group, nil
+}
+
+func (m *mockSubscriptionGroupClient) GetSubscriptionGroup(ctx context.Context, id string) (*subscriptions.Group, error) {
+	return &subscriptions.Group{ID: id}, nil
+}
+
+func (m *mockSubscriptionGroupClient) ModifySubscriptionGroup(ctx context.Context, id string, group subscriptions.Group) (*subscriptions.Group, error) {
+	group.ID = id
+	return &group, nil
+}
+
+func (m *mockSubscriptionGroupClient) DeleteSubscriptionGroup(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *mockSubscriptionGroupClient) ListSubscriptionGroupLocalizations(ctx context.Context, groupID string) ([]subscriptions.GroupLocalization, error) {
+	return m.localizations, nil
+}
+
+func (m *mockSubscriptionGroupClient) CreateSubscriptionGroupLocalization(ctx context.Context, localization subscriptions.GroupLocalization) (*subscriptions.GroupLocalization, error) {
+	if m.createErr != nil {
+		return nil, m.createErr
+	}
+	m.created = append(m.created, localization.Locale)
+	localization.ID = fmt.Sprintf("%s-id", localization.Locale)
+	m.localizations = append(m.localizations, localization)
+	return &localization, nil
+}
+
+func (m *mockSubscriptionGroupClient) ModifySubscriptionGroupLocalization(ctx context.Context, id string, localization subscriptions.GroupLocalization) (*subscriptions.GroupLocalization, error) {
+	m.modified = append(m.modified, id)
+	for i := range m.localizations {
+		if m.localizations[i].ID == id {
+			m.localizations[i].Name = localization.Name
+			m.localizations[i].CustomAppName = localization.CustomAppName
+		}
+	}
+	return &localization, nil
+}
+
+func (m *mockSubscriptionGroupClient) DeleteSubscriptionGroupLocalization(ctx context.Context, id string) error {
+	m.deleted = append(m.deleted, id)
+	remaining := []subscriptions.GroupLocalization{}
+	for _, localization := range m.localizations {
+		if localization.ID != id {
+			remaining = append(remaining, localization)
+		}
+	}
+	m.localizations = remaining
+	return nil
+}
+
+func subscriptionGroupResourceSchema() schema.Schema {
+	r := &SubscriptionGroupResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func subscriptionGroupLocalizationVal(locale string, name string, customAppName interface{}) tftypes.Value {
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"locale":          tftypes.String,
+		"name":            tftypes.String,
+		"custom_app_name": tftypes.String,
+	}}, map[string]tftypes.Value{
+		"locale":          tftypes.NewValue(tftypes.String, locale),
+		"name":            tftypes.NewValue(tftypes.String, name),
+		"custom_app_name": tftypes.NewValue(tftypes.String, customAppName),
+	})
+}
+
+func subscriptionGroupVal(s schema.Schema, id interface{}, localizations ...tftypes.Value) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":             tftypes.NewValue(tftypes.String, id),
+		"app_id":         tftypes.NewValue(tftypes.String, "1234567890"),
+		"reference_name": tftypes.NewValue(tftypes.String, "Pro"),
+		"localization": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"locale":          tftypes.String,
+			"name":            tftypes.String,
+			"custom_app_name": tftypes.String,
+		}}}, localizations),
+	})
+}
+
+func TestSubscriptionGroupResource_Create_SavesGroupWhenLocalizationsFail(t *testing.T) {
+	client := &mockSubscriptionGroupClient{createErr: fmt.Errorf("locale not supported")}
+	r := &SubscriptionGroupResource{client: client}
+
+	s := subscriptionGroupResourceSchema()
+	planVal := subscriptionGroupVal(s, tftypes.UnknownValue, subscriptionGroupLocalizationVal("en-GB", "Pro", nil))
+
+	state := createSavedState(t, r, s, planVal, "group-id")
+
+	var data SubscriptionGroupResourceModel
+	state.Get(context.Background(), &data)
+
+	if len(data.Localizations.Elements()) != 0 {
+		t.Errorf("expected no localizations in state, got %d", len(data.Localizations.Elements()))
+	}
+}
+
+func TestSubscriptionGroupResource_Update_ReconcilesLocalizationsByLocale(t *testing.T) {
+	client := &mockSubscriptionGroupClient{
+		localizations: []subscriptions.GroupLocalization{
+			{ID: "en-GB-id", Locale: "en-GB", Name: "Example Pro"},
+			{ID: "de-DE-id", Locale: "de-DE", Name: "Example Pro"},
+			{ID: "fr-FR-id", Locale: "fr-FR", Name: "Example Pro"},
+		},
+	}
+	r := &SubscriptionGroupResource{client: client}
+
+	s := subscriptionGroupResourceSchema()
+	stateVal := subscriptionGroupVal(s, "group-id",
+		subscriptionGroupLocalizationVal("en-GB", "Example Pro", nil),
+		subscriptionGroupLocalizationVal("de-DE", "Example Pro", nil),
+		subscriptionGroupLocalizationVal("fr-FR", "Example Pro", nil),
+	)
+	planVal := subscriptionGroupVal(s, "group-id",
+		subscriptionGroupLocalizationVal("en-GB", "Example Pro", nil),
+		subscriptionGroupLocalizationVal("de-DE", "Example Pro", "Beispiel"),
+		subscriptionGroupLocalizationVal("es-ES", "Example Pro", nil),
+	)
+
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Update(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.created) != 1 || client.created[0] != "es-ES" {
+		t.Errorf("expected only es-ES to be created, got %v", client.created)
+	}
+	if len(client.modified) != 1 || client.modified[0] != "de-DE-id" {
+		t.Errorf("expected only de-DE to be modified, got %v", client.modified)
+	}
+	if len(client.deleted) != 1 || client.deleted[0] != "fr-FR-id" {
+		t.Errorf("expected only fr-FR to be deleted, got %v", client.deleted)
+	}
+
+	var data SubscriptionGroupResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if len(data.Localizations.Elements()) != 3 {
+		t.Errorf("expected 3 localizations in state, got %d", len(data.Localizations.Elements()))
+	}
+}
+
+func TestSubscriptionGroupResource_ValidateConfig_RejectsDuplicateLocales(t *testing.T) {
+	r := SubscriptionGroupResource{}
+
+	s := subscriptionGroupResourceSchema()
+	req := resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: s, Raw: subscriptionGroupVal(s, nil,
+			subscriptionGroupLocalizationVal("en-GB", "Example Pro", nil),
+			subscriptionGroupLocalizationVal("en-GB", "Example Plus", nil),
+		)},
+	}
+	resp := &resource.ValidateConfigResponse{}
+
+	r.ValidateConfig(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for a duplicate locale")
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/subscriptions"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SubscriptionIntroductoryOfferResource{}
+var _ resource.ResourceWithImportState = &SubscriptionIntroductoryOfferResource{}
+var _ resource.ResourceWithValidateConfig = &SubscriptionIntroductoryOfferResource{}
+
+type subscriptionIntroductoryOfferClient interface {
+	CreateSubscriptionIntroductoryOffer(ctx context.Context, offer subscriptions.IntroductoryOffer) (*subscriptions.IntroductoryOffer, error)
+	GetSubscriptionIntroductoryOffer(ctx context.Context, id string) (*subscriptions.IntroductoryOffer, error)
+	ModifySubscriptionIntroductoryOffer(ctx context.Context, id string, offer subscriptions.IntroductoryOffer) (*subscriptions.IntroductoryOffer, error)
+	DeleteSubscriptionIntroductoryOffer(ctx context.Context, id string) error
+}
+
+func NewSubscriptionIntroductoryOfferResource() resource.Resource {
+	return &SubscriptionIntroductoryOfferResource{}
+}
+
+// SubscriptionIntroductoryOfferResource defines the resource implementation.
+type SubscriptionIntroductoryOfferResource struct {
+	client subscriptionIntroductoryOfferClient
+}
+
+// SubscriptionIntroductoryOfferResourceModel describes the resource data model.
+type SubscriptionIntroductoryOfferResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	SubscriptionID  types.String `tfsdk:"subscription_id"`
+	Territory       types.String `tfsdk:"territory"`
+	OfferMode       types.String `tfsdk:"offer_mode"`
+	Duration        types.String `tfsdk:"duration"`
+	NumberOfPeriods types.Int64  `tfsdk:"number_of_periods"`
+	PricePointID    types.String `tfsdk:"price_point_id"`
+	StartDate       types.String `tfsdk:"start_date"`
+	EndDate         types.String `tfsdk:"end_date"`
+}
+
+func (r *SubscriptionIntroductoryOfferResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription_introductory_offer"
+}
+
+func (r *SubscriptionIntroductoryOfferResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an introductory offer for new subscribers to an auto-renewable subscription in a territory. " +
+			"Only the end date can be changed once the offer has been created.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the introductory offer.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscription_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the subscription the offer applies to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"territory": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The three-letter code of the territory the offer is available in, e.g. `GBR`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"offer_mode": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "How customers pay for the offer: `FREE_TRIAL`, `PAY_AS_YOU_GO` or `PAY_UP_FRONT`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"duration": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The length of each offer period, e.g. `ONE_WEEK` or `THREE_MONTHS`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"number_of_periods": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "The number of periods the offer lasts. Only pay-as-you-go offers can have more than one.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"price_point_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The identifier of the subscription price point the offer charges. Required unless `offer_mode` is `FREE_TRIAL`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"start_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The date the offer becomes available, in `YYYY-MM-DD` format. Omit to start straight away.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"end_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The date the offer stops being available, in `YYYY-MM-DD` format. Omit to run indefinitely.",
+			},
+		},
+	}
+}
+
+func (r *SubscriptionIntroductoryOfferResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(subscriptionIntroductoryOfferClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected subscriptionIntroductoryOfferClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r SubscriptionIntroductoryOfferResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SubscriptionIntroductoryOfferResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	priced := types.BoolValue(!data.PricePointID.IsNull())
+	if data.PricePointID.IsUnknown() {
+		priced = types.BoolUnknown()
+	}
+
+	resp.Diagnostics.Append(validateSubscriptionOffer(data.OfferMode, data.Duration, data.NumberOfPeriods, priced, path.Root("price_point_id"))...)
+}
+
+func (r *SubscriptionIntroductoryOfferResource) populateState(data *SubscriptionIntroductoryOfferResourceModel, offer *subscriptions.IntroductoryOffer) {
+	data.ID = types.StringValue(offer.ID)
+	data.SubscriptionID = types.StringValue(offer.SubscriptionID)
+	data.Territory = types.StringValue(offer.Territory)
+	data.OfferMode = types.StringValue(offer.OfferMode)
+	data.Duration = types.StringValue(offer.Duration)
+	data.NumberOfPeriods = types.Int64Value(offer.NumberOfPeriods)
+	data.PricePointID = optionalString(offer.PricePointID)
+	data.StartDate = optionalString(offer.StartDate)
+	data.EndDate = optionalString(offer.EndDate)
+}
+
+func (r *SubscriptionIntroductoryOfferResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SubscriptionIntroductoryOfferResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offer, err := r.client.CreateSubscriptionIntroductoryOffer(ctx, subscriptions.IntroductoryOffer{
+		SubscriptionID:  data.SubscriptionID.ValueString(),
+		Territory:       data.Territory.ValueString(),
+		OfferMode:       data.OfferMode.ValueString(),
+		Duration:        data.Duration.ValueString(),
+		NumberOfPeriods: data.NumberOfPeriods.ValueInt64(),
+		PricePointID:    data.PricePointID.ValueString(),
+		StartDate:       data.StartDate.ValueString(),
+		EndDate:         data.EndDate.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create introductory offer, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a subscription introductory offer")
+
+	r.populateState(&data, offer)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionIntroductoryOfferResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SubscriptionIntroductoryOfferResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offer, err := r.client.GetSubscriptionIntroductoryOffer(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read introductory offer, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, offer)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionIntroductoryOfferResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SubscriptionIntroductoryOfferResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offer, err := r.client.ModifySubscriptionIntroductoryOffer(ctx, data.ID.ValueString(), subscriptions.IntroductoryOffer{
+		EndDate: data.EndDate.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify introductory offer, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified a subscription introductory offer")
+
+	r.populateState(&data, offer)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionIntroductoryOfferResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SubscriptionIntroductoryOfferResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSubscriptionIntroductoryOffer(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete introductory offer, got error: %s", err))
+		return
+	}
+}
+
+func (r *SubscriptionIntroductoryOfferResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/subscriptions"
+)
+
+type mockSubscriptionIntroductoryOfferClient struct {
+	modified *subscriptions.IntroductoryOffer
+}
+
+func (m *mockSubscriptionIntroductoryOfferClient) CreateSubscriptionIntroductoryOffer(ctx context.Context, offer subscriptions.IntroductoryOffer) (*subscriptions.IntroductoryOffer, error) {
+	offer.ID = "offer-id"
+	return &offer, nil
+}
+
+func (m *mockSubscriptionIntroductoryOfferClient) GetSubscriptionIntroductoryOffer(ctx context.Context, id string) (*subscriptions.IntroductoryOffer, error) {
+	return &subscriptions.IntroductoryOffer{ID: id}, nil
+}
+
+func (m *mockSubscriptionIntroductoryOfferClient) ModifySubscriptionIntroductoryOffer(ctx context.Context, id string, offer subscriptions.IntroductoryOffer) (*subscriptions.IntroductoryOffer, error) {
+	m.modified = &offer
+	return &subscriptions.IntroductoryOffer{
+		ID:              id,
+		SubscriptionID:  "subscription-id",
+		Territory:       "USA",
+		OfferMode:       "PAY_AS_YOU_GO",
+		Duration:        "ONE_MONTH",
+		NumberOfPeriods: 3,
+		PricePointID:    "usa-199",
+		EndDate:         offer.EndDate,
+	}, nil
+}
+
+func (m *mockSubscriptionIntroductoryOfferClient) DeleteSubscriptionIntroductoryOffer(ctx context.Context, id string) error {
+	return nil
+}
+
+func subscriptionIntroductoryOfferResourceSchema() schema.Schema {
+	r := &SubscriptionIntroductoryOfferResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func subscriptionIntroductoryOfferVal(s schema.Schema, id interface{}, mode string, duration string, periods int64, pricePointID interface{}, endDate interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, id),
+		"subscription_id":   tftypes.NewValue(tftypes.String, "subscription-id"),
+		"territory":         tftypes.NewValue(tftypes.String, "USA"),
+		"offer_mode":        tftypes.NewValue(tftypes.String, mode),
+		"duration":          tftypes.NewValue(tftypes.String, duration),
+		"number_of_periods": tftypes.NewValue(tftypes.Number, periods),
+		"price_point_id":    tftypes.NewValue(tftypes.String, pricePointID),
+		"start_date":        tftypes.NewValue(tftypes.String, nil),
+		"end_date":          tftypes.NewValue(tftypes.String, endDate),
+	})
+}
+
+func TestSubscriptionIntroductoryOfferResource_Update_OnlySendsEndDate(t *testing.T) {
+	client := &mockSubscriptionIntroductoryOfferClient{}
+	r := &SubscriptionIntroductoryOfferResource{client: client}
+
+	s := subscriptionIntroductoryOfferResourceSchema()
+	stateVal := subscriptionIntroductoryOfferVal(s, "offer-id", "PAY_AS_YOU_GO", "ONE_MONTH", 3, "usa-199", nil)
+	planVal := subscriptionIntroductoryOfferVal(s, "offer-id", "PAY_AS_YOU_GO", "ONE_MONTH", 3, "usa-199", "2026-03-31")
+
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Update(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if client.modified.EndDate != "2026-03-31" {
+		t.Errorf("expected end date '2026-03-31', got %q", client.modified.EndDate)
+	}
+	if client.modified.PricePointID != "" || client.modified.OfferMode != "" {
+		t.Errorf("expected only the end date to be sent, got %+v", client.modified)
+	}
+
+	var data SubscriptionIntroductoryOfferResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.EndDate.ValueString() != "2026-03-31" {
+		t.Errorf("expected end date '2026-03-31' in state, got %q", data.EndDate.ValueString())
+	}
+}
+
+func TestSubscriptionIntroductoryOfferResource_ValidateConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		mode         string
+		duration     string
+		periods      int64
+		pricePointID interface{}
+		expectError  bool
+	}{
+		{name: "free trial", mode: "FREE_TRIAL", duration: "ONE_WEEK", periods: 1},
+		{name: "pay as you go", mode: "PAY_AS_YOU_GO", duration: "ONE_MONTH", periods: 3, pricePointID: "usa-199"},
+		{name: "pay up front", mode: "PAY_UP_FRONT", duration: "SIX_MONTHS", periods: 1, pricePointID: "usa-999"},
+		{name: "unsupported mode", mode: "DISCOUNT", duration: "ONE_MONTH", periods: 1, pricePointID: "usa-199", expectError: true},
+		{name: "unsupported duration", mode: "FREE_TRIAL", duration: "FIVE_DAYS", periods: 1, expectError: true},
+		{name: "priced free trial", mode: "FREE_TRIAL", duration: "ONE_WEEK", periods: 1, pricePointID: "usa-199", expectError: true},
+		{name: "unpriced paid offer", mode: "PAY_UP_FRONT", duration: "ONE_MONTH", periods: 1, expectError: true},
+		{name: "repeated pay up front", mode: "PAY_UP_FRONT", duration: "ONE_MONTH", periods: 2, pricePointID: "usa-199", expectError: true},
+		{name: "too many periods", mode: "PAY_AS_YOU_GO", duration: "ONE_MONTH", periods: 13, pricePointID: "usa-199", expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := SubscriptionIntroductoryOfferResource{}
+
+			s := subscriptionIntroductoryOfferResourceSchema()
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: subscriptionIntroductoryOfferVal(s, nil, tc.mode, tc.duration, tc.periods, tc.pricePointID, nil)},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error=%t, got diagnostics %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
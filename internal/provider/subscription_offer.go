@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/oliver-binns/appstore-go/subscriptions"
+)
+
+// subscriptionOfferModes are the ways a customer can pay for a subscription offer.
+var subscriptionOfferModes = []string{"FREE_TRIAL", "PAY_AS_YOU_GO", "PAY_UP_FRONT"}
+
+// subscriptionOfferDurations are the lengths of a subscription offer period.
+var subscriptionOfferDurations = []string{"THREE_DAYS", "ONE_WEEK", "TWO_WEEKS", "ONE_MONTH", "TWO_MONTHS", "THREE_MONTHS", "SIX_MONTHS", "ONE_YEAR"}
+
+// offerPriceModel describes a `price` block of a subscription offer.
+type offerPriceModel struct {
+	Territory    types.String `tfsdk:"territory"`
+	PricePointID types.String `tfsdk:"price_point_id"`
+}
+
+var offerPriceAttrTypes = map[string]attr.Type{
+	"territory":      types.StringType,
+	"price_point_id": types.StringType,
+}
+
+func offerPriceBlock() schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		MarkdownDescription: "The price of the offer in a territory. Required unless `offer_mode` is `FREE_TRIAL`.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"territory": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "The three-letter code of the territory, e.g. `GBR`.",
+				},
+				"price_point_id": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "The identifier of the subscription price point the offer charges.",
+				},
+			},
+		},
+	}
+}
+
+// validateSubscriptionOffer checks the mode, duration and number of periods
+// of an offer, and that paid offers have a price. priced is unknown when the
+// price can't be known until apply.
+func validateSubscriptionOffer(mode types.String, duration types.String, periods types.Int64, priced types.Bool, price path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !mode.IsNull() && !mode.IsUnknown() && !slices.Contains(subscriptionOfferModes, mode.ValueString()) {
+		diags.AddAttributeError(
+			path.Root("offer_mode"),
+			"Invalid Configuration",
+			fmt.Sprintf("`offer_mode` must be one of %s, got %q.", strings.Join(subscriptionOfferModes, ", "), mode.ValueString()),
+		)
+	}
+
+	if !duration.IsNull() && !duration.IsUnknown() && !slices.Contains(subscriptionOfferDurations, duration.ValueString()) {
+		diags.AddAttributeError(
+			path.Root("duration"),
+			"Invalid Configuration",
+			fmt.Sprintf("`duration` must be one of %s, got %q.", strings.Join(subscriptionOfferDurations, ", "), duration.ValueString()),
+		)
+	}
+
+	if mode.IsNull() || mode.IsUnknown() || periods.IsUnknown() {
+		return diags
+	}
+
+	// Only pay-as-you-go offers repeat; the others cover a single period.
+	if !periods.IsNull() {
+		if mode.ValueString() == "PAY_AS_YOU_GO" && (periods.ValueInt64() < 1 || periods.ValueInt64() > 12) {
+			diags.AddAttributeError(
+				path.Root("number_of_periods"),
+				"Invalid Configuration",
+				fmt.Sprintf("`number_of_periods` must be between 1 and 12, got %d.", periods.ValueInt64()),
+			)
+		}
+		if mode.ValueString() != "PAY_AS_YOU_GO" && periods.ValueInt64() != 1 {
+			diags.AddAttributeError(
+				path.Root("number_of_periods"),
+				"Invalid Configuration",
+				fmt.Sprintf("`number_of_periods` must be 1 when `offer_mode` is %q.", mode.ValueString()),
+			)
+		}
+	}
+
+	if priced.IsUnknown() {
+		return diags
+	}
+	if mode.ValueString() == "FREE_TRIAL" && priced.ValueBool() {
+		diags.AddAttributeError(price, "Invalid Configuration", "Free trials cannot have a price.")
+	}
+	if mode.ValueString() != "FREE_TRIAL" && !priced.ValueBool() {
+		diags.AddAttributeError(price, "Invalid Configuration", fmt.Sprintf("A price is required when `offer_mode` is %q.", mode.ValueString()))
+	}
+
+	return diags
+}
+
+// offerPricesFrom reads the configured prices from a `price` set.
+func offerPricesFrom(ctx context.Context, prices types.Set) ([]subscriptions.OfferPrice, diag.Diagnostics) {
+	var models []offerPriceModel
+	diags := prices.ElementsAs(ctx, &models, false)
+
+	converted := []subscriptions.OfferPrice{}
+	for _, model := range models {
+		converted = append(converted, subscriptions.OfferPrice{
+			Territory:    model.Territory.ValueString(),
+			PricePointID: model.PricePointID.ValueString(),
+		})
+	}
+	return converted, diags
+}
+
+// offerPricesValue converts offer prices from App Store Connect into a set for state.
+func offerPricesValue(ctx context.Context, prices []subscriptions.OfferPrice) (types.Set, diag.Diagnostics) {
+	models := []offerPriceModel{}
+	for _, price := range prices {
+		models = append(models, offerPriceModel{
+			Territory:    types.StringValue(price.Territory),
+			PricePointID: types.StringValue(price.PricePointID),
+		})
+	}
+	return types.SetValueFrom(ctx, types.ObjectType{AttrTypes: offerPriceAttrTypes}, models)
+}
+
+// offerPriced reports whether a `price` set has any prices, or unknown if the
+// set itself won't be known until apply.
+func offerPriced(prices types.Set) types.Bool {
+	if prices.IsUnknown() {
+		return types.BoolUnknown()
+	}
+	return types.BoolValue(len(prices.Elements()) > 0)
+}
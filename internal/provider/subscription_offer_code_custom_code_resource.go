@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/subscriptions"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SubscriptionOfferCodeCustomCodeResource{}
+var _ resource.ResourceWithImportState = &SubscriptionOfferCodeCustomCodeResource{}
+var _ resource.ResourceWithValidateConfig = &SubscriptionOfferCodeCustomCodeResource{}
+var _ resource.ResourceWithModifyPlan = &SubscriptionOfferCodeCustomCodeResource{}
+
+type subscriptionOfferCodeCustomCodeClient interface {
+	CreateSubscriptionOfferCodeCustomCode(ctx context.Context, code subscriptions.CustomCode) (*subscriptions.CustomCode, error)
+	GetSubscriptionOfferCodeCustomCode(ctx context.Context, id string) (*subscriptions.CustomCode, error)
+	ModifySubscriptionOfferCodeCustomCode(ctx context.Context, id string, code subscriptions.CustomCode) (*subscriptions.CustomCode, error)
+}
+
+func NewSubscriptionOfferCodeCustomCodeResource() resource.Resource {
+	return &SubscriptionOfferCodeCustomCodeResource{}
+}
+
+// SubscriptionOfferCodeCustomCodeResource defines the resource implementation.
+type SubscriptionOfferCodeCustomCodeResource struct {
+	client subscriptionOfferCodeCustomCodeClient
+}
+
+// SubscriptionOfferCodeCustomCodeResourceModel describes the resource data model.
+type SubscriptionOfferCodeCustomCodeResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	OfferCodeID    types.String `tfsdk:"offer_code_id"`
+	CustomCode     types.String `tfsdk:"custom_code"`
+	NumberOfCodes  types.Int64  `tfsdk:"number_of_codes"`
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+	Active         types.Bool   `tfsdk:"active"`
+}
+
+func (r *SubscriptionOfferCodeCustomCodeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription_offer_code_custom_code"
+}
+
+func (r *SubscriptionOfferCodeCustomCodeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a custom code, such as `SPRING2026`, that customers can enter to redeem an offer code. " +
+			"Custom codes can't be deleted, so destroying this resource deactivates the code.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the custom code.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"offer_code_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the offer code the custom code redeems.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_code": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The code customers enter. Letters and numbers only.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"number_of_codes": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The number of times the code can be redeemed.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"expiration_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The date the code expires, in `YYYY-MM-DD` format. Omit for a code that doesn't expire.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the code can be redeemed. Once deactivated, a custom code cannot be reactivated.",
+			},
+		},
+	}
+}
+
+func (r *SubscriptionOfferCodeCustomCodeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(subscriptionOfferCodeCustomCodeClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected subscriptionOfferCodeCustomCodeClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r SubscriptionOfferCodeCustomCodeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SubscriptionOfferCodeCustomCodeResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CustomCode.IsNull() && !data.CustomCode.IsUnknown() {
+		code := data.CustomCode.ValueString()
+		alphanumeric := code != ""
+		for _, c := range code {
+			if (c < 'A' || c > 'Z') && (c < 'a' || c > 'z') && (c < '0' || c > '9') {
+				alphanumeric = false
+			}
+		}
+		if !alphanumeric {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("custom_code"),
+				"Invalid Configuration",
+				fmt.Sprintf("`custom_code` must only contain letters and numbers, got %q.", code),
+			)
+		}
+	}
+
+	if !data.NumberOfCodes.IsNull() && !data.NumberOfCodes.IsUnknown() && data.NumberOfCodes.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("number_of_codes"),
+			"Invalid Configuration",
+			fmt.Sprintf("`number_of_codes` must be at least 1, got %d.", data.NumberOfCodes.ValueInt64()),
+		)
+	}
+
+	if !data.ExpirationDate.IsNull() && !data.ExpirationDate.IsUnknown() {
+		if _, err := time.Parse(time.DateOnly, data.ExpirationDate.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expiration_date"),
+				"Invalid Configuration",
+				fmt.Sprintf("`expiration_date` must be in YYYY-MM-DD format, got %q.", data.ExpirationDate.ValueString()),
+			)
+		}
+	}
+}
+
+func (r SubscriptionOfferCodeCustomCodeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(validateActiveChange(ctx, req, "A custom code")...)
+}
+
+func (r *SubscriptionOfferCodeCustomCodeResource) populateState(data *SubscriptionOfferCodeCustomCodeResourceModel, code *subscriptions.CustomCode) {
+	data.ID = types.StringValue(code.ID)
+	data.OfferCodeID = types.StringValue(code.OfferCodeID)
+	data.CustomCode = types.StringValue(code.CustomCode)
+	data.NumberOfCodes = types.Int64Value(code.NumberOfCodes)
+	data.ExpirationDate = optionalString(code.ExpirationDate)
+	data.Active = types.BoolValue(code.Active)
+}
+
+func (r *SubscriptionOfferCodeCustomCodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SubscriptionOfferCodeCustomCodeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	code, err := r.client.CreateSubscriptionOfferCodeCustomCode(ctx, subscriptions.CustomCode{
+		OfferCodeID:    data.OfferCodeID.ValueString(),
+		CustomCode:     data.CustomCode.ValueString(),
+		NumberOfCodes:  data.NumberOfCodes.ValueInt64(),
+		ExpirationDate: data.ExpirationDate.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom code, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a custom offer code")
+
+	// Custom codes are always created active.
+	if !data.Active.ValueBool() {
+		code, err = r.client.ModifySubscriptionOfferCodeCustomCode(ctx, code.ID, subscriptions.CustomCode{Active: false})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deactivate custom code, got error: %s", err))
+			return
+		}
+	}
+
+	r.populateState(&data, code)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionOfferCodeCustomCodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SubscriptionOfferCodeCustomCodeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	code, err := r.client.GetSubscriptionOfferCodeCustomCode(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom code, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, code)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionOfferCodeCustomCodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SubscriptionOfferCodeCustomCodeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	code, err := r.client.ModifySubscriptionOfferCodeCustomCode(ctx, data.ID.ValueString(), subscriptions.CustomCode{
+		Active: data.Active.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify custom code, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified a custom offer code")
+
+	r.populateState(&data, code)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionOfferCodeCustomCodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SubscriptionOfferCodeCustomCodeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Custom codes cannot be deleted, so they are deactivated instead.
+	if !data.Active.ValueBool() {
+		return
+	}
+
+	_, err := r.client.ModifySubscriptionOfferCodeCustomCode(ctx, data.ID.ValueString(), subscriptions.CustomCode{Active: false})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deactivate custom code, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deactivated a custom offer code")
+}
+
+func (r *SubscriptionOfferCodeCustomCodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
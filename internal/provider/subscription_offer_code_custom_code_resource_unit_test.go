@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func subscriptionOfferCodeCustomCodeResourceSchema() schema.Schema {
+	r := &SubscriptionOfferCodeCustomCodeResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func subscriptionOfferCodeCustomCodeVal(s schema.Schema, code string, numberOfCodes int64, expirationDate interface{}) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":              tftypes.NewValue(tftypes.String, nil),
+		"offer_code_id":   tftypes.NewValue(tftypes.String, "offer-code-id"),
+		"custom_code":     tftypes.NewValue(tftypes.String, code),
+		"number_of_codes": tftypes.NewValue(tftypes.Number, numberOfCodes),
+		"expiration_date": tftypes.NewValue(tftypes.String, expirationDate),
+		"active":          tftypes.NewValue(tftypes.Bool, true),
+	})
+}
+
+func TestSubscriptionOfferCodeCustomCodeResource_ValidateConfig(t *testing.T) {
+	tests := map[string]struct {
+		code           string
+		numberOfCodes  int64
+		expirationDate interface{}
+		expectError    bool
+	}{
+		"valid":                {code: "SPRING2026", numberOfCodes: 1000, expirationDate: "2026-06-30"},
+		"no expiration date":   {code: "SPRING2026", numberOfCodes: 1000},
+		"punctuation":          {code: "SPRING-2026", numberOfCodes: 1000, expectError: true},
+		"empty code":           {code: "", numberOfCodes: 1000, expectError: true},
+		"no codes":             {code: "SPRING2026", numberOfCodes: 0, expectError: true},
+		"malformed expiration": {code: "SPRING2026", numberOfCodes: 1000, expirationDate: "30/06/2026", expectError: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := SubscriptionOfferCodeCustomCodeResource{}
+
+			s := subscriptionOfferCodeCustomCodeResourceSchema()
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: subscriptionOfferCodeCustomCodeVal(s, tt.code, tt.numberOfCodes, tt.expirationDate)},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Errorf("expected error: %v, got diagnostics: %v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
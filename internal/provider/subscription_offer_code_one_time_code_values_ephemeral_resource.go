@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &SubscriptionOfferCodeOneTimeCodeValuesEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &SubscriptionOfferCodeOneTimeCodeValuesEphemeralResource{}
+
+type subscriptionOfferCodeOneTimeCodeValuesClient interface {
+	ListSubscriptionOfferCodeOneTimeUseCodeValues(ctx context.Context, id string) ([]string, error)
+}
+
+func NewSubscriptionOfferCodeOneTimeCodeValuesEphemeralResource() ephemeral.EphemeralResource {
+	return &SubscriptionOfferCodeOneTimeCodeValuesEphemeralResource{}
+}
+
+// SubscriptionOfferCodeOneTimeCodeValuesEphemeralResource defines the ephemeral resource implementation.
+type SubscriptionOfferCodeOneTimeCodeValuesEphemeralResource struct {
+	client subscriptionOfferCodeOneTimeCodeValuesClient
+}
+
+// SubscriptionOfferCodeOneTimeCodeValuesEphemeralResourceModel describes the ephemeral resource data model.
+type SubscriptionOfferCodeOneTimeCodeValuesEphemeralResourceModel struct {
+	OneTimeCodesID types.String `tfsdk:"one_time_codes_id"`
+	Codes          types.List   `tfsdk:"codes"`
+}
+
+func (e *SubscriptionOfferCodeOneTimeCodeValuesEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription_offer_code_one_time_code_values"
+}
+
+func (e *SubscriptionOfferCodeOneTimeCodeValuesEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the codes in a batch of one-time use offer codes. " +
+			"The codes are never stored in state, so they can be passed straight to another ephemeral resource or write-only argument.",
+		Attributes: map[string]schema.Attribute{
+			"one_time_codes_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the batch of one-time use codes.",
+			},
+			"codes": schema.ListAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The codes customers can redeem.",
+			},
+		},
+	}
+}
+
+func (e *SubscriptionOfferCodeOneTimeCodeValuesEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(subscriptionOfferCodeOneTimeCodeValuesClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected subscriptionOfferCodeOneTimeCodeValuesClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+}
+
+func (e *SubscriptionOfferCodeOneTimeCodeValuesEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data SubscriptionOfferCodeOneTimeCodeValuesEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	codes, err := e.client.ListSubscriptionOfferCodeOneTimeUseCodeValues(ctx, data.OneTimeCodesID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read one-time use code values, got error: %s", err))
+		return
+	}
+
+	value, diags := types.ListValueFrom(ctx, types.StringType, codes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Codes = value
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type mockSubscriptionOfferCodeOneTimeCodeValuesClient struct {
+	codes []string
+}
+
+func (m *mockSubscriptionOfferCodeOneTimeCodeValuesClient) ListSubscriptionOfferCodeOneTimeUseCodeValues(ctx context.Context, id string) ([]string, error) {
+	return m.codes, nil
+}
+
+func TestSubscriptionOfferCodeOneTimeCodeValuesEphemeralResource_Open(t *testing.T) {
+	client := &mockSubscriptionOfferCodeOneTimeCodeValuesClient{codes: []string{"AAAA1111", "BBBB2222"}}
+	e := &SubscriptionOfferCodeOneTimeCodeValuesEphemeralResource{client: client}
+
+	schemaResp := &ephemeral.SchemaResponse{}
+	e.Schema(context.Background(), ephemeral.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	configVal := tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"one_time_codes_id": tftypes.NewValue(tftypes.String, "codes-id"),
+		"codes":             tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+	})
+
+	req := ephemeral.OpenRequest{
+		Config: tfsdk.Config{Schema: s, Raw: configVal},
+	}
+	resp := &ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{Schema: s, Raw: configVal},
+	}
+
+	e.Open(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+
+	var data SubscriptionOfferCodeOneTimeCodeValuesEphemeralResourceModel
+	resp.Result.Get(context.Background(), &data)
+
+	if len(data.Codes.Elements()) != 2 {
+		t.Errorf("expected 2 codes, got %d", len(data.Codes.Elements()))
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/subscriptions"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SubscriptionOfferCodeOneTimeCodesResource{}
+var _ resource.ResourceWithImportState = &SubscriptionOfferCodeOneTimeCodesResource{}
+var _ resource.ResourceWithValidateConfig = &SubscriptionOfferCodeOneTimeCodesResource{}
+var _ resource.ResourceWithModifyPlan = &SubscriptionOfferCodeOneTimeCodesResource{}
+
+type subscriptionOfferCodeOneTimeCodesClient interface {
+	CreateSubscriptionOfferCodeOneTimeUseCodes(ctx context.Context, codes subscriptions.OneTimeUseCodes) (*subscriptions.OneTimeUseCodes, error)
+	GetSubscriptionOfferCodeOneTimeUseCodes(ctx context.Context, id string) (*subscriptions.OneTimeUseCodes, error)
+	ModifySubscriptionOfferCodeOneTimeUseCodes(ctx context.Context, id string, codes subscriptions.OneTimeUseCodes) (*subscriptions.OneTimeUseCodes, error)
+}
+
+func NewSubscriptionOfferCodeOneTimeCodesResource() resource.Resource {
+	return &SubscriptionOfferCodeOneTimeCodesResource{}
+}
+
+// SubscriptionOfferCodeOneTimeCodesResource defines the resource implementation.
+type SubscriptionOfferCodeOneTimeCodesResource struct {
+	client subscriptionOfferCodeOneTimeCodesClient
+}
+
+// SubscriptionOfferCodeOneTimeCodesResourceModel describes the resource data model.
+type SubscriptionOfferCodeOneTimeCodesResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	OfferCodeID    types.String `tfsdk:"offer_code_id"`
+	NumberOfCodes  types.Int64  `tfsdk:"number_of_codes"`
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+	CreatedDate    types.String `tfsdk:"created_date"`
+	Active         types.Bool   `tfsdk:"active"`
+}
+
+func (r *SubscriptionOfferCodeOneTimeCodesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription_offer_code_one_time_codes"
+}
+
+func (r *SubscriptionOfferCodeOneTimeCodesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a batch of one-time use codes for an offer code. The codes themselves are never " +
+			"stored in state; read them with the `appstoreconnect_subscription_offer_code_one_time_code_values` ephemeral resource. " +
+			"Batches can't be deleted, so destroying this resource deactivates the codes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the batch of codes.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"offer_code_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the offer code the codes redeem.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"number_of_codes": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The number of codes to generate.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"expiration_date": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The date the codes expire, in `YYYY-MM-DD` format.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date the codes were generated.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the codes can be redeemed. Once deactivated, codes cannot be reactivated.",
+			},
+		},
+	}
+}
+
+func (r *SubscriptionOfferCodeOneTimeCodesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(subscriptionOfferCodeOneTimeCodesClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected subscriptionOfferCodeOneTimeCodesClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r SubscriptionOfferCodeOneTimeCodesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SubscriptionOfferCodeOneTimeCodesResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.NumberOfCodes.IsNull() && !data.NumberOfCodes.IsUnknown() && data.NumberOfCodes.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("number_of_codes"),
+			"Invalid Configuration",
+			fmt.Sprintf("`number_of_codes` must be at least 1, got %d.", data.NumberOfCodes.ValueInt64()),
+		)
+	}
+
+	if !data.ExpirationDate.IsNull() && !data.ExpirationDate.IsUnknown() {
+		if _, err := time.Parse(time.DateOnly, data.ExpirationDate.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expiration_date"),
+				"Invalid Configuration",
+				fmt.Sprintf("`expiration_date` must be in YYYY-MM-DD format, got %q.", data.ExpirationDate.ValueString()),
+			)
+		}
+	}
+}
+
+func (r SubscriptionOfferCodeOneTimeCodesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(validateActiveChange(ctx, req, "A batch of one-time use codes")...)
+}
+
+func (r *SubscriptionOfferCodeOneTimeCodesResource) populateState(data *SubscriptionOfferCodeOneTimeCodesResourceModel, codes *subscriptions.OneTimeUseCodes) {
+	data.ID = types.StringValue(codes.ID)
+	data.OfferCodeID = types.StringValue(codes.OfferCodeID)
+	data.NumberOfCodes = types.Int64Value(codes.NumberOfCodes)
+	data.ExpirationDate = types.StringValue(codes.ExpirationDate)
+	data.CreatedDate = optionalString(codes.CreatedDate)
+	data.Active = types.BoolValue(codes.Active)
+}
+
+func (r *SubscriptionOfferCodeOneTimeCodesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SubscriptionOfferCodeOneTimeCodesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	codes, err := r.client.CreateSubscriptionOfferCodeOneTimeUseCodes(ctx, subscriptions.OneTimeUseCodes{
+		OfferCodeID:    data.OfferCodeID.ValueString(),
+		NumberOfCodes:  data.NumberOfCodes.ValueInt64(),
+		ExpirationDate: data.ExpirationDate.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to generate one-time use codes, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "generated a batch of one-time use codes")
+
+	// Codes are always generated active.
+	if !data.Active.ValueBool() {
+		codes, err = r.client.ModifySubscriptionOfferCodeOneTimeUseCodes(ctx, codes.ID, subscriptions.OneTimeUseCodes{Active: false})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deactivate one-time use codes, got error: %s", err))
+			return
+		}
+	}
+
+	r.populateState(&data, codes)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionOfferCodeOneTimeCodesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SubscriptionOfferCodeOneTimeCodesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	codes, err := r.client.GetSubscriptionOfferCodeOneTimeUseCodes(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read one-time use codes, got error: %s", err))
+		return
+	}
+
+	r.populateState(&data, codes)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionOfferCodeOneTimeCodesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SubscriptionOfferCodeOneTimeCodesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	codes, err := r.client.ModifySubscriptionOfferCodeOneTimeUseCodes(ctx, data.ID.ValueString(), subscriptions.OneTimeUseCodes{
+		Active: data.Active.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify one-time use codes, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified a batch of one-time use codes")
+
+	r.populateState(&data, codes)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionOfferCodeOneTimeCodesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SubscriptionOfferCodeOneTimeCodesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// One-time use codes cannot be deleted, so they are deactivated instead.
+	if !data.Active.ValueBool() {
+		return
+	}
+
+	_, err := r.client.ModifySubscriptionOfferCodeOneTimeUseCodes(ctx, data.ID.ValueString(), subscriptions.OneTimeUseCodes{Active: false})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deactivate one-time use codes, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deactivated a batch of one-time use codes")
+}
+
+func (r *SubscriptionOfferCodeOneTimeCodesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
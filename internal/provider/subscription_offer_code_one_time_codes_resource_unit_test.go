@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/subscriptions"
+)
+
+type mockSubscriptionOfferCodeOneTimeCodesClient struct {
+	modified []subscriptions.OneTimeUseCodes
+}
+
+func (m *mockSubscriptionOfferCodeOneTimeCodesClient) CreateSubscriptionOfferCodeOneTimeUseCodes(ctx context.Context, codes subscriptions.OneTimeUseCodes) (*subscriptions.OneTimeUseCodes, error) {
+	codes.ID = "codes-id"
+	codes.CreatedDate = "2026-03-01"
+	codes.Active = true
+	return &codes, nil
+}
+
+func (m *mockSubscriptionOfferCodeOneTimeCodesClient) GetSubscriptionOfferCodeOneTimeUseCodes(ctx context.Context, id string) (*subscriptions.OneTimeUseCodes, error) {
+	return &subscriptions.OneTimeUseCodes{ID: id}, nil
+}
+
+func (m *mockSubscriptionOfferCodeOneTimeCodesClient) ModifySubscriptionOfferCodeOneTimeUseCodes(ctx context.Context, id string, codes subscriptions.OneTimeUseCodes) (*subscriptions.OneTimeUseCodes, error) {
+	m.modified = append(m.modified, codes)
+	return &subscriptions.OneTimeUseCodes{
+		ID:             id,
+		OfferCodeID:    "offer-code-id",
+		NumberOfCodes:  500,
+		ExpirationDate: "2026-06-30",
+		CreatedDate:    "2026-03-01",
+		Active:         codes.Active,
+	}, nil
+}
+
+func subscriptionOfferCodeOneTimeCodesResourceSchema() schema.Schema {
+	r := &SubscriptionOfferCodeOneTimeCodesResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func subscriptionOfferCodeOneTimeCodesVal(s schema.Schema, id interface{}, active bool) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":              tftypes.NewValue(tftypes.String, id),
+		"offer_code_id":   tftypes.NewValue(tftypes.String, "offer-code-id"),
+		"number_of_codes": tftypes.NewValue(tftypes.Number, 500),
+		"expiration_date": tftypes.NewValue(tftypes.String, "2026-06-30"),
+		"created_date":    tftypes.NewValue(tftypes.String, nil),
+		"active":          tftypes.NewValue(tftypes.Bool, active),
+	})
+}
+
+func TestSubscriptionOfferCodeOneTimeCodesResource_Create_Inactive(t *testing.T) {
+	client := &mockSubscriptionOfferCodeOneTimeCodesClient{}
+	r := &SubscriptionOfferCodeOneTimeCodesResource{client: client}
+
+	s := subscriptionOfferCodeOneTimeCodesResourceSchema()
+	planVal := subscriptionOfferCodeOneTimeCodesVal(s, nil, false)
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.modified) != 1 || client.modified[0].Active {
+		t.Errorf("expected the codes to be deactivated after generation, got %v", client.modified)
+	}
+
+	var data SubscriptionOfferCodeOneTimeCodesResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.Active.ValueBool() {
+		t.Error("expected the codes to be inactive in state")
+	}
+	if data.CreatedDate.ValueString() != "2026-03-01" {
+		t.Errorf("expected created date '2026-03-01', got %q", data.CreatedDate.ValueString())
+	}
+}
+
+func TestSubscriptionOfferCodeOneTimeCodesResource_Delete_Deactivates(t *testing.T) {
+	tests := map[string]struct {
+		active         bool
+		expectModified int
+	}{
+		"active":   {active: true, expectModified: 1},
+		"inactive": {active: false, expectModified: 0},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &mockSubscriptionOfferCodeOneTimeCodesClient{}
+			r := &SubscriptionOfferCodeOneTimeCodesResource{client: client}
+
+			s := subscriptionOfferCodeOneTimeCodesResourceSchema()
+			req := resource.DeleteRequest{
+				State: tfsdk.State{Schema: s, Raw: subscriptionOfferCodeOneTimeCodesVal(s, "codes-id", tt.active)},
+			}
+			resp := &resource.DeleteResponse{}
+
+			r.Delete(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+			}
+			if len(client.modified) != tt.expectModified {
+				t.Errorf("expected %d modifications, got %d", tt.expectModified, len(client.modified))
+			}
+		})
+	}
+}
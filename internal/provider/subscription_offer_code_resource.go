@@ -0,0 +1,396 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/subscriptions"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SubscriptionOfferCodeResource{}
+var _ resource.ResourceWithImportState = &SubscriptionOfferCodeResource{}
+var _ resource.ResourceWithValidateConfig = &SubscriptionOfferCodeResource{}
+var _ resource.ResourceWithModifyPlan = &SubscriptionOfferCodeResource{}
+
+// offerCodeCustomerEligibilities are the kinds of customer who can redeem an offer code.
+var offerCodeCustomerEligibilities = []string{"NEW", "LAPSED", "WIN_BACK", "EXISTING"}
+
+// offerCodeOfferEligibilities are the ways an offer code combines with an introductory offer.
+var offerCodeOfferEligibilities = []string{"STACK_WITH_INTRO_OFFERS", "REPLACE_INTRO_OFFERS"}
+
+type subscriptionOfferCodeClient interface {
+	CreateSubscriptionOfferCode(ctx context.Context, offerCode subscriptions.OfferCode) (*subscriptions.OfferCode, error)
+	GetSubscriptionOfferCode(ctx context.Context, id string) (*subscriptions.OfferCode, error)
+	ModifySubscriptionOfferCode(ctx context.Context, id string, offerCode subscriptions.OfferCode) (*subscriptions.OfferCode, error)
+}
+
+func NewSubscriptionOfferCodeResource() resource.Resource {
+	return &SubscriptionOfferCodeResource{}
+}
+
+// SubscriptionOfferCodeResource defines the resource implementation.
+type SubscriptionOfferCodeResource struct {
+	client subscriptionOfferCodeClient
+}
+
+// SubscriptionOfferCodeResourceModel describes the resource data model.
+type SubscriptionOfferCodeResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	SubscriptionID        types.String `tfsdk:"subscription_id"`
+	Name                  types.String `tfsdk:"name"`
+	CustomerEligibilities types.Set    `tfsdk:"customer_eligibilities"`
+	OfferEligibility      types.String `tfsdk:"offer_eligibility"`
+	OfferMode             types.String `tfsdk:"offer_mode"`
+	Duration              types.String `tfsdk:"duration"`
+	NumberOfPeriods       types.Int64  `tfsdk:"number_of_periods"`
+	Active                types.Bool   `tfsdk:"active"`
+	Prices                types.Set    `tfsdk:"price"`
+}
+
+func (r *SubscriptionOfferCodeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription_offer_code"
+}
+
+func (r *SubscriptionOfferCodeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	prices := offerPriceBlock()
+	prices.PlanModifiers = []planmodifier.Set{
+		setplanmodifier.RequiresReplace(),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an offer code campaign for an auto-renewable subscription. Codes are generated with " +
+			"`appstoreconnect_subscription_offer_code_one_time_codes` and `appstoreconnect_subscription_offer_code_custom_code`. " +
+			"Offer codes can't be deleted, so destroying this resource deactivates the offer.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the offer code.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscription_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the subscription the offer applies to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name used for the offer code in App Store Connect.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"customer_eligibilities": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "The customers who can redeem the offer: any of `NEW`, `LAPSED`, `WIN_BACK` and `EXISTING`.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"offer_eligibility": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Whether the offer can be redeemed on top of an introductory offer: `STACK_WITH_INTRO_OFFERS` or `REPLACE_INTRO_OFFERS`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"offer_mode": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "How customers pay for the offer: `FREE_TRIAL`, `PAY_AS_YOU_GO` or `PAY_UP_FRONT`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"duration": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The length of each offer period, e.g. `ONE_WEEK` or `THREE_MONTHS`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"number_of_periods": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "The number of periods the offer lasts. Only pay-as-you-go offers can have more than one.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether codes for the offer can be redeemed. Once deactivated, an offer code cannot be reactivated.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"price": prices,
+		},
+	}
+}
+
+func (r *SubscriptionOfferCodeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(subscriptionOfferCodeClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected subscriptionOfferCodeClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r SubscriptionOfferCodeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SubscriptionOfferCodeResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateSubscriptionOffer(data.OfferMode, data.Duration, data.NumberOfPeriods, offerPriced(data.Prices), path.Root("price"))...)
+
+	if !data.OfferEligibility.IsNull() && !data.OfferEligibility.IsUnknown() && !slices.Contains(offerCodeOfferEligibilities, data.OfferEligibility.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("offer_eligibility"),
+			"Invalid Configuration",
+			fmt.Sprintf("`offer_eligibility` must be one of %s, got %q.", strings.Join(offerCodeOfferEligibilities, ", "), data.OfferEligibility.ValueString()),
+		)
+	}
+
+	if data.CustomerEligibilities.IsNull() || data.CustomerEligibilities.IsUnknown() {
+		return
+	}
+
+	var eligibilities []types.String
+	resp.Diagnostics.Append(data.CustomerEligibilities.ElementsAs(ctx, &eligibilities, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(eligibilities) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("customer_eligibilities"),
+			"Invalid Configuration",
+			"At least one customer eligibility is required.",
+		)
+	}
+	for _, eligibility := range eligibilities {
+		if !eligibility.IsUnknown() && !slices.Contains(offerCodeCustomerEligibilities, eligibility.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("customer_eligibilities"),
+				"Invalid Configuration",
+				fmt.Sprintf("`customer_eligibilities` must only contain %s, got %q.", strings.Join(offerCodeCustomerEligibilities, ", "), eligibility.ValueString()),
+			)
+		}
+	}
+}
+
+func (r SubscriptionOfferCodeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(validateActiveChange(ctx, req, "An offer code")...)
+}
+
+// validateActiveChange rejects plans that reactivate an offer code or its
+// codes, which App Store Connect doesn't allow once they have been deactivated.
+func validateActiveChange(ctx context.Context, req resource.ModifyPlanRequest, kind string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Nothing to check when the resource is being created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return diags
+	}
+
+	var state, plan types.Bool
+	diags.Append(req.State.GetAttribute(ctx, path.Root("active"), &state)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("active"), &plan)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !state.IsNull() && !state.ValueBool() && !plan.IsUnknown() && plan.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("active"),
+			"Invalid Configuration",
+			fmt.Sprintf("%s cannot be reactivated once it has been deactivated.", kind),
+		)
+	}
+	return diags
+}
+
+func (r *SubscriptionOfferCodeResource) populateState(ctx context.Context, data *SubscriptionOfferCodeResourceModel, offerCode *subscriptions.OfferCode) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(offerCode.ID)
+	data.SubscriptionID = types.StringValue(offerCode.SubscriptionID)
+	data.Name = types.StringValue(offerCode.Name)
+	data.OfferEligibility = types.StringValue(offerCode.OfferEligibility)
+	data.OfferMode = types.StringValue(offerCode.OfferMode)
+	data.Duration = types.StringValue(offerCode.Duration)
+	data.NumberOfPeriods = types.Int64Value(offerCode.NumberOfPeriods)
+	data.Active = types.BoolValue(offerCode.Active)
+
+	eligibilities, d := types.SetValueFrom(ctx, types.StringType, offerCode.CustomerEligibilities)
+	diags.Append(d...)
+	data.CustomerEligibilities = eligibilities
+
+	prices, d := offerPricesValue(ctx, offerCode.Prices)
+	diags.Append(d...)
+	data.Prices = prices
+
+	return diags
+}
+
+func (r *SubscriptionOfferCodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SubscriptionOfferCodeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var eligibilities []string
+	resp.Diagnostics.Append(data.CustomerEligibilities.ElementsAs(ctx, &eligibilities, false)...)
+
+	prices, diags := offerPricesFrom(ctx, data.Prices)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offerCode, err := r.client.CreateSubscriptionOfferCode(ctx, subscriptions.OfferCode{
+		SubscriptionID:        data.SubscriptionID.ValueString(),
+		Name:                  data.Name.ValueString(),
+		CustomerEligibilities: eligibilities,
+		OfferEligibility:      data.OfferEligibility.ValueString(),
+		OfferMode:             data.OfferMode.ValueString(),
+		Duration:              data.Duration.ValueString(),
+		NumberOfPeriods:       data.NumberOfPeriods.ValueInt64(),
+		Prices:                prices,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create offer code, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a subscription offer code")
+
+	// Save the code before deactivating it.
+	active := data.Active.ValueBool()
+	resp.Diagnostics.Append(r.populateState(ctx, &data, offerCode)...)
+	resp.Diagnostics.Append(saveCreated(ctx, &resp.State, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Offer codes are always created active.
+	if active {
+		return
+	}
+
+	offerCode, err = r.client.ModifySubscriptionOfferCode(ctx, offerCode.ID, subscriptions.OfferCode{Active: false})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deactivate offer code, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, offerCode)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionOfferCodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SubscriptionOfferCodeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offerCode, err := r.client.GetSubscriptionOfferCode(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read offer code, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, offerCode)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionOfferCodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SubscriptionOfferCodeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offerCode, err := r.client.ModifySubscriptionOfferCode(ctx, data.ID.ValueString(), subscriptions.OfferCode{
+		Active: data.Active.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify offer code, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified a subscription offer code")
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, offerCode)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionOfferCodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SubscriptionOfferCodeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Offer codes cannot be deleted, so they are deactivated instead.
+	if !data.Active.ValueBool() {
+		return
+	}
+
+	_, err := r.client.ModifySubscriptionOfferCode(ctx, data.ID.ValueString(), subscriptions.OfferCode{Active: false})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deactivate offer code, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deactivated a subscription offer code")
+}
+
+func (r *SubscriptionOfferCodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
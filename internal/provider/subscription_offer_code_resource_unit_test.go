@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/subscriptions"
+)
+
+type mockSubscriptionOfferCodeClient struct {
+	modifyErr error
+}
+
+func (m *mockSubscriptionOfferCodeClient) CreateSubscriptionOfferCode(ctx context.Context, offerCode subscriptions.OfferCode) (*subscriptions.OfferCode, error) {
+	offerCode.ID = "offer-code-id"
+	offerCode.Active = true
+	return &offerCode, nil
+}
+
+func (m *mockSubscriptionOfferCodeClient) GetSubscriptionOfferCode(ctx context.Context, id string) (*subscriptions.OfferCode, error) {
+	return &subscriptions.OfferCode{ID: id}, nil
+}
+
+func (m *mockSubscriptionOfferCodeClient) ModifySubscriptionOfferCode(ctx context.Context, id string, offerCode subscriptions.OfferCode) (*subscriptions.OfferCode, error) {
+	if m.modifyErr != nil {
+		return nil, m.modifyErr
+	}
+	offerCode.ID = id
+	return &offerCode, nil
+}
+
+func subscriptionOfferCodeResourceSchema() schema.Schema {
+	r := &SubscriptionOfferCodeResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func subscriptionOfferCodeVal(s schema.Schema, active bool, eligibilities ...string) tftypes.Value {
+	values := make([]tftypes.Value, len(eligibilities))
+	for i, eligibility := range eligibilities {
+		values[i] = tftypes.NewValue(tftypes.String, eligibility)
+	}
+
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                     tftypes.NewValue(tftypes.String, "offer-code-id"),
+		"subscription_id":        tftypes.NewValue(tftypes.String, "subscription-id"),
+		"name":                   tftypes.NewValue(tftypes.String, "Spring Sale"),
+		"customer_eligibilities": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, values),
+		"offer_eligibility":      tftypes.NewValue(tftypes.String, "STACK_WITH_INTRO_OFFERS"),
+		"offer_mode":             tftypes.NewValue(tftypes.String, "FREE_TRIAL"),
+		"duration":               tftypes.NewValue(tftypes.String, "ONE_MONTH"),
+		"number_of_periods":      tftypes.NewValue(tftypes.Number, 1),
+		"active":                 tftypes.NewValue(tftypes.Bool, active),
+		"price":                  offerPricesVal(),
+	})
+}
+
+func TestSubscriptionOfferCodeResource_ValidateConfig(t *testing.T) {
+	tests := map[string]struct {
+		eligibilities []string
+		expectError   bool
+	}{
+		"valid":         {eligibilities: []string{"NEW", "LAPSED"}},
+		"empty":         {eligibilities: []string{}, expectError: true},
+		"unknown value": {eligibilities: []string{"NEW", "EVERYONE"}, expectError: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := SubscriptionOfferCodeResource{}
+
+			s := subscriptionOfferCodeResourceSchema()
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: subscriptionOfferCodeVal(s, true, tt.eligibilities...)},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Errorf("expected error: %v, got diagnostics: %v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestSubscriptionOfferCodeResource_ModifyPlan_RejectsReactivation(t *testing.T) {
+	r := SubscriptionOfferCodeResource{}
+
+	s := subscriptionOfferCodeResourceSchema()
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: s, Raw: subscriptionOfferCodeVal(s, false, "NEW")},
+		Plan:  tfsdk.Plan{Schema: s, Raw: subscriptionOfferCodeVal(s, true, "NEW")},
+	}
+	resp := &resource.ModifyPlanResponse{
+		Plan: req.Plan,
+	}
+
+	r.ModifyPlan(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when reactivating an offer code")
+	}
+}
+
+func TestSubscriptionOfferCodeResource_ModifyPlan_AllowsDeactivation(t *testing.T) {
+	r := SubscriptionOfferCodeResource{}
+
+	s := subscriptionOfferCodeResourceSchema()
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: s, Raw: subscriptionOfferCodeVal(s, true, "NEW")},
+		Plan:  tfsdk.Plan{Schema: s, Raw: subscriptionOfferCodeVal(s, false, "NEW")},
+	}
+	resp := &resource.ModifyPlanResponse{
+		Plan: req.Plan,
+	}
+
+	r.ModifyPlan(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+}
+
+func TestSubscriptionOfferCodeResource_Create_SavesCodeWhenDeactivationFails(t *testing.T) {
+	r := &SubscriptionOfferCodeResource{client: &mockSubscriptionOfferCodeClient{modifyErr: fmt.Errorf("service unavailable")}}
+
+	s := subscriptionOfferCodeResourceSchema()
+	planVal := subscriptionOfferCodeVal(s, false, "NEW")
+
+	state := createSavedState(t, r, s, planVal, "offer-code-id")
+
+	var data SubscriptionOfferCodeResourceModel
+	state.Get(context.Background(), &data)
+
+	if !data.Active.ValueBool() {
+		t.Error("expected the saved offer code to still be active")
+	}
+}
@@ -0,0 +1,339 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/subscriptions"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SubscriptionPriceScheduleResource{}
+var _ resource.ResourceWithValidateConfig = &SubscriptionPriceScheduleResource{}
+
+type subscriptionPriceScheduleClient interface {
+	ListSubscriptionPrices(ctx context.Context, subscriptionID string) ([]subscriptions.Price, error)
+	ListSubscriptionPricePointEqualizations(ctx context.Context, pricePointID string) ([]subscriptions.PricePoint, error)
+	CreateSubscriptionPrice(ctx context.Context, price subscriptions.Price) (*subscriptions.Price, error)
+	DeleteSubscriptionPrice(ctx context.Context, id string) error
+}
+
+func NewSubscriptionPriceScheduleResource() resource.Resource {
+	return &SubscriptionPriceScheduleResource{}
+}
+
+// SubscriptionPriceScheduleResource defines the resource implementation.
+type SubscriptionPriceScheduleResource struct {
+	client subscriptionPriceScheduleClient
+}
+
+// SubscriptionPriceScheduleResourceModel describes the resource data model.
+type SubscriptionPriceScheduleResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	SubscriptionID       types.String `tfsdk:"subscription_id"`
+	BaseTerritory        types.String `tfsdk:"base_territory"`
+	PreserveCurrentPrice types.Bool   `tfsdk:"preserve_current_price"`
+	Prices               types.Set    `tfsdk:"price"`
+}
+
+func (r *SubscriptionPriceScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription_price_schedule"
+}
+
+func (r *SubscriptionPriceScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the prices of an auto-renewable subscription. Base territory prices are equalized " +
+			"across every other territory using Apple's equivalent price points, unless a territory has its own price " +
+			"starting on the same date. Only scheduled prices that haven't started yet can be removed, so destroying " +
+			"this resource only removes it from state. Equalized prices aren't tracked, so this resource can't be imported.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the subscription.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscription_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the subscription to price.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"base_territory": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The three-letter code of the territory other prices are equalized from, e.g. `USA`.",
+			},
+			"preserve_current_price": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether existing subscribers keep paying their current price when the price changes.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"price": priceBlock("subscription"),
+		},
+	}
+}
+
+func (r *SubscriptionPriceScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(subscriptionPriceScheduleClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected subscriptionPriceScheduleClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r SubscriptionPriceScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SubscriptionPriceScheduleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatePrices(ctx, data.BaseTerritory, data.Prices)...)
+}
+
+// matchingPrice finds the price in App Store Connect for a territory and
+// start date. Without a start date, it finds the latest price that has
+// started, ignoring scheduled prices from the configuration so that the
+// undated price stays tied to the price it created once they start.
+func (r *SubscriptionPriceScheduleResource) matchingPrice(prices []subscriptions.Price, territory string, startDate string, configured []scheduledPrice, baseTerritory string) *subscriptions.Price {
+	today := time.Now().UTC().Format(priceDateFormat)
+
+	var match *subscriptions.Price
+	for i, price := range prices {
+		if price.Territory != territory {
+			continue
+		}
+		if startDate != "" {
+			if price.StartDate == startDate {
+				return &prices[i]
+			}
+			continue
+		}
+		if price.StartDate > today || isScheduledPrice(configured, baseTerritory, territory, price.StartDate) {
+			continue
+		}
+		if match == nil || price.StartDate > match.StartDate {
+			match = &prices[i]
+		}
+	}
+	return match
+}
+
+// isScheduledPrice reports whether a configured price starts on startDate in
+// territory, either directly or by equalization from the base territory.
+func isScheduledPrice(configured []scheduledPrice, baseTerritory string, territory string, startDate string) bool {
+	for _, price := range configured {
+		if price.StartDate != "" && price.StartDate == startDate && (price.Territory == territory || price.Territory == baseTerritory) {
+			return true
+		}
+	}
+	return false
+}
+
+// expandPrices equalizes base territory prices into every other territory,
+// keeping the configured price wherever a territory has one for the same date.
+func (r *SubscriptionPriceScheduleResource) expandPrices(ctx context.Context, baseTerritory string, prices []scheduledPrice) ([]scheduledPrice, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configured := map[scheduledPrice]bool{}
+	for _, price := range prices {
+		configured[scheduledPrice{Territory: price.Territory, StartDate: price.StartDate}] = true
+	}
+
+	expanded := append([]scheduledPrice{}, prices...)
+	for _, price := range prices {
+		if price.Territory != baseTerritory {
+			continue
+		}
+
+		equalizations, err := r.client.ListSubscriptionPricePointEqualizations(ctx, price.PricePointID)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to list equalized price points, got error: %s", err))
+			return nil, diags
+		}
+
+		for _, point := range equalizations {
+			if configured[scheduledPrice{Territory: point.Territory, StartDate: price.StartDate}] {
+				continue
+			}
+			expanded = append(expanded, scheduledPrice{
+				Territory:    point.Territory,
+				PricePointID: point.ID,
+				StartDate:    price.StartDate,
+			})
+		}
+	}
+	return expanded, diags
+}
+
+// applyPrices creates any prices App Store Connect doesn't have yet and
+// removes scheduled prices that are no longer planned.
+func (r *SubscriptionPriceScheduleResource) applyPrices(ctx context.Context, data *SubscriptionPriceScheduleResourceModel) diag.Diagnostics {
+	prices, diags := scheduledPricesFrom(ctx, data.Prices)
+	if diags.HasError() {
+		return diags
+	}
+
+	expanded, expandDiags := r.expandPrices(ctx, data.BaseTerritory.ValueString(), prices)
+	diags.Append(expandDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	existing, err := r.client.ListSubscriptionPrices(ctx, data.SubscriptionID.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list subscription prices, got error: %s", err))
+		return diags
+	}
+
+	planned := map[scheduledPrice]bool{}
+	for _, price := range expanded {
+		planned[scheduledPrice{Territory: price.Territory, StartDate: price.StartDate}] = true
+
+		match := r.matchingPrice(existing, price.Territory, price.StartDate, prices, data.BaseTerritory.ValueString())
+		if match != nil && match.PricePointID == price.PricePointID {
+			continue
+		}
+
+		_, err := r.client.CreateSubscriptionPrice(ctx, subscriptions.Price{
+			SubscriptionID:       data.SubscriptionID.ValueString(),
+			Territory:            price.Territory,
+			PricePointID:         price.PricePointID,
+			StartDate:            price.StartDate,
+			PreserveCurrentPrice: data.PreserveCurrentPrice.ValueBool(),
+		})
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to set %s price, got error: %s", price.Territory, err))
+			return diags
+		}
+	}
+
+	today := time.Now().UTC().Format(priceDateFormat)
+	for _, price := range existing {
+		// Prices that have already started are part of the subscription's history.
+		if price.StartDate <= today || planned[scheduledPrice{Territory: price.Territory, StartDate: price.StartDate}] {
+			continue
+		}
+		if err := r.client.DeleteSubscriptionPrice(ctx, price.ID); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete scheduled %s price, got error: %s", price.Territory, err))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// populateState refreshes the configured prices from App Store Connect.
+// Equalized prices aren't tracked, so only the configured territories are read.
+func (r *SubscriptionPriceScheduleResource) populateState(ctx context.Context, data *SubscriptionPriceScheduleResourceModel, existing []subscriptions.Price) diag.Diagnostics {
+	data.ID = types.StringValue(data.SubscriptionID.ValueString())
+
+	prices, diags := scheduledPricesFrom(ctx, data.Prices)
+	if diags.HasError() {
+		return diags
+	}
+
+	current := []scheduledPrice{}
+	for _, price := range prices {
+		match := r.matchingPrice(existing, price.Territory, price.StartDate, prices, data.BaseTerritory.ValueString())
+		if match == nil {
+			continue
+		}
+		price.PricePointID = match.PricePointID
+		current = append(current, price)
+	}
+
+	var setDiags diag.Diagnostics
+	data.Prices, setDiags = pricesValue(ctx, current)
+	diags.Append(setDiags...)
+	return diags
+}
+
+func (r *SubscriptionPriceScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SubscriptionPriceScheduleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyPrices(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "set a subscription price schedule")
+
+	data.ID = types.StringValue(data.SubscriptionID.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionPriceScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SubscriptionPriceScheduleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := r.client.ListSubscriptionPrices(ctx, data.SubscriptionID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list subscription prices, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, existing)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionPriceScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SubscriptionPriceScheduleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyPrices(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a subscription price schedule")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionPriceScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A subscription must always have a price, so prices are only removed from state.
+	tflog.Trace(ctx, "removed subscription price schedule from state")
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/subscriptions"
+)
+
+type mockSubscriptionPriceScheduleClient struct {
+	prices  []subscriptions.Price
+	created []subscriptions.Price
+	deleted []string
+}
+
+func (m *mockSubscriptionPriceScheduleClient) ListSubscriptionPrices(ctx context.Context, subscriptionID string) ([]subscriptions.Price, error) {
+	return m.prices, nil
+}
+
+func (m *mockSubscriptionPriceScheduleClient) ListSubscriptionPricePointEqualizations(ctx context.Context, pricePointID string) ([]subscriptions.PricePoint, error) {
+	return []subscriptions.PricePoint{
+		{ID: pricePointID + "-gbr", Territory: "GBR"},
+		{ID: pricePointID + "-fra", Territory: "FRA"},
+	}, nil
+}
+
+func (m *mockSubscriptionPriceScheduleClient) CreateSubscriptionPrice(ctx context.Context, price subscriptions.Price) (*subscriptions.Price, error) {
+	m.created = append(m.created, price)
+	return &price, nil
+}
+
+func (m *mockSubscriptionPriceScheduleClient) DeleteSubscriptionPrice(ctx context.Context, id string) error {
+	m.deleted = append(m.deleted, id)
+	return nil
+}
+
+func subscriptionPriceScheduleResourceSchema() schema.Schema {
+	r := &SubscriptionPriceScheduleResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func subscriptionPriceScheduleVal(s schema.Schema, id interface{}, prices tftypes.Value) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                     tftypes.NewValue(tftypes.String, id),
+		"subscription_id":        tftypes.NewValue(tftypes.String, "subscription-id"),
+		"base_territory":         tftypes.NewValue(tftypes.String, "USA"),
+		"preserve_current_price": tftypes.NewValue(tftypes.Bool, true),
+		"price":                  prices,
+	})
+}
+
+func TestSubscriptionPriceScheduleResource_Update_EqualizesBasePriceAndKeepsOverrides(t *testing.T) {
+	client := &mockSubscriptionPriceScheduleClient{
+		prices: []subscriptions.Price{
+			{ID: "usa-current", Territory: "USA", PricePointID: "usa-499", StartDate: "2020-01-01"},
+			{ID: "fra-current", Territory: "FRA", PricePointID: "usa-499-fra", StartDate: "2020-01-01"},
+			{ID: "usa-future", Territory: "USA", PricePointID: "usa-599", StartDate: "2999-01-01"},
+		},
+	}
+	r := &SubscriptionPriceScheduleResource{client: client}
+
+	s := subscriptionPriceScheduleResourceSchema()
+	stateVal := subscriptionPriceScheduleVal(s, "subscription-id", pricesVal(
+		priceVal("USA", "usa-499", nil),
+		priceVal("USA", "usa-599", "2999-01-01"),
+	))
+	planVal := subscriptionPriceScheduleVal(s, "subscription-id", pricesVal(
+		priceVal("USA", "usa-499", nil),
+		priceVal("GBR", "gbr-399", nil),
+	))
+
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Update(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+
+	// USA and the equalized FRA price are unchanged, so only the GBR override is created.
+	if len(client.created) != 1 {
+		t.Fatalf("expected 1 price to be created, got %v", client.created)
+	}
+	if client.created[0].Territory != "GBR" || client.created[0].PricePointID != "gbr-399" {
+		t.Errorf("expected the GBR override to be created, got %+v", client.created[0])
+	}
+	if !client.created[0].PreserveCurrentPrice {
+		t.Error("expected the current price to be preserved for existing subscribers")
+	}
+	if len(client.deleted) != 1 || client.deleted[0] != "usa-future" {
+		t.Errorf("expected the unplanned scheduled price to be deleted, got %v", client.deleted)
+	}
+}
+
+func TestSubscriptionPriceScheduleResource_Read_RefreshesConfiguredPrices(t *testing.T) {
+	client := &mockSubscriptionPriceScheduleClient{
+		prices: []subscriptions.Price{
+			{ID: "usa-old", Territory: "USA", PricePointID: "usa-399", StartDate: "2019-01-01"},
+			{ID: "usa-current", Territory: "USA", PricePointID: "usa-549", StartDate: "2020-01-01"},
+			{ID: "gbr-current", Territory: "GBR", PricePointID: "gbr-399", StartDate: "2020-01-01"},
+		},
+	}
+	r := &SubscriptionPriceScheduleResource{client: client}
+
+	s := subscriptionPriceScheduleResourceSchema()
+	stateVal := subscriptionPriceScheduleVal(s, "subscription-id", pricesVal(
+		priceVal("USA", "usa-499", nil),
+		priceVal("USA", "usa-599", "2999-01-01"),
+	))
+
+	req := resource.ReadRequest{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+	resp := &resource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+
+	var data SubscriptionPriceScheduleResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	var prices []priceModel
+	data.Prices.ElementsAs(context.Background(), &prices, false)
+
+	// The scheduled price no longer exists, and the current price has drifted.
+	if len(prices) != 1 {
+		t.Fatalf("expected 1 price in state, got %d", len(prices))
+	}
+	if prices[0].PricePointID.ValueString() != "usa-549" {
+		t.Errorf("expected the current USA price point 'usa-549', got %q", prices[0].PricePointID.ValueString())
+	}
+}
+
+func TestSubscriptionPriceScheduleResource_KeepsCurrentPriceAfterScheduledPriceStarts(t *testing.T) {
+	// The USA price scheduled for 2021 has started, so it is now the price in effect.
+	client := &mockSubscriptionPriceScheduleClient{
+		prices: []subscriptions.Price{
+			{ID: "usa-current", Territory: "USA", PricePointID: "usa-499", StartDate: "2020-01-01"},
+			{ID: "usa-scheduled", Territory: "USA", PricePointID: "usa-599", StartDate: "2021-01-01"},
+			{ID: "gbr-current", Territory: "GBR", PricePointID: "usa-499-gbr", StartDate: "2020-01-01"},
+			{ID: "gbr-scheduled", Territory: "GBR", PricePointID: "usa-599-gbr", StartDate: "2021-01-01"},
+			{ID: "fra-current", Territory: "FRA", PricePointID: "usa-499-fra", StartDate: "2020-01-01"},
+			{ID: "fra-scheduled", Territory: "FRA", PricePointID: "usa-599-fra", StartDate: "2021-01-01"},
+		},
+	}
+	r := &SubscriptionPriceScheduleResource{client: client}
+
+	s := subscriptionPriceScheduleResourceSchema()
+	configVal := subscriptionPriceScheduleVal(s, "subscription-id", pricesVal(
+		priceVal("USA", "usa-499", nil),
+		priceVal("USA", "usa-599", "2021-01-01"),
+	))
+
+	readResp := &resource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: configVal},
+	}
+	r.Read(context.Background(), resource.ReadRequest{State: tfsdk.State{Schema: s, Raw: configVal}}, readResp)
+
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", readResp.Diagnostics.Errors()[0].Detail())
+	}
+
+	var data SubscriptionPriceScheduleResourceModel
+	readResp.State.Get(context.Background(), &data)
+
+	var prices []priceModel
+	data.Prices.ElementsAs(context.Background(), &prices, false)
+
+	for _, price := range prices {
+		if price.StartDate.IsNull() && price.PricePointID.ValueString() != "usa-499" {
+			t.Errorf("expected the undated price to stay 'usa-499', got %q", price.PricePointID.ValueString())
+		}
+	}
+	if len(prices) != 2 {
+		t.Fatalf("expected 2 prices in state, got %d", len(prices))
+	}
+
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: s, Raw: configVal},
+		State: tfsdk.State{Schema: s, Raw: configVal},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: configVal},
+	}
+
+	r.Update(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.created) != 0 {
+		t.Errorf("expected no prices to be created, got %+v", client.created)
+	}
+}
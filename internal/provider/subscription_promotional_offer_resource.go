@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/subscriptions"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SubscriptionPromotionalOfferResource{}
+var _ resource.ResourceWithImportState = &SubscriptionPromotionalOfferResource{}
+var _ resource.ResourceWithValidateConfig = &SubscriptionPromotionalOfferResource{}
+
+type subscriptionPromotionalOfferClient interface {
+	CreateSubscriptionPromotionalOffer(ctx context.Context, offer subscriptions.PromotionalOffer) (*subscriptions.PromotionalOffer, error)
+	GetSubscriptionPromotionalOffer(ctx context.Context, id string) (*subscriptions.PromotionalOffer, error)
+	ModifySubscriptionPromotionalOffer(ctx context.Context, id string, offer subscriptions.PromotionalOffer) (*subscriptions.PromotionalOffer, error)
+	DeleteSubscriptionPromotionalOffer(ctx context.Context, id string) error
+}
+
+func NewSubscriptionPromotionalOfferResource() resource.Resource {
+	return &SubscriptionPromotionalOfferResource{}
+}
+
+// SubscriptionPromotionalOfferResource defines the resource implementation.
+type SubscriptionPromotionalOfferResource struct {
+	client subscriptionPromotionalOfferClient
+}
+
+// SubscriptionPromotionalOfferResourceModel describes the resource data model.
+type SubscriptionPromotionalOfferResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	SubscriptionID  types.String `tfsdk:"subscription_id"`
+	Name            types.String `tfsdk:"name"`
+	OfferCode       types.String `tfsdk:"offer_code"`
+	OfferMode       types.String `tfsdk:"offer_mode"`
+	Duration        types.String `tfsdk:"duration"`
+	NumberOfPeriods types.Int64  `tfsdk:"number_of_periods"`
+	Prices          types.Set    `tfsdk:"price"`
+}
+
+func (r *SubscriptionPromotionalOfferResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription_promotional_offer"
+}
+
+func (r *SubscriptionPromotionalOfferResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a promotional offer that an app can present to existing or lapsed subscribers. " +
+			"Only the prices can be changed once the offer has been created.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the promotional offer.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscription_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the subscription the offer applies to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name used for the offer in App Store Connect.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"offer_code": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier the app passes to StoreKit when presenting the offer.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"offer_mode": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "How customers pay for the offer: `FREE_TRIAL`, `PAY_AS_YOU_GO` or `PAY_UP_FRONT`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"duration": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The length of each offer period, e.g. `ONE_WEEK` or `THREE_MONTHS`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"number_of_periods": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "The number of periods the offer lasts. Only pay-as-you-go offers can have more than one.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"price": offerPriceBlock(),
+		},
+	}
+}
+
+func (r *SubscriptionPromotionalOfferResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(subscriptionPromotionalOfferClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected subscriptionPromotionalOfferClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r SubscriptionPromotionalOfferResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SubscriptionPromotionalOfferResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateSubscriptionOffer(data.OfferMode, data.Duration, data.NumberOfPeriods, offerPriced(data.Prices), path.Root("price"))...)
+}
+
+func (r *SubscriptionPromotionalOfferResource) populateState(ctx context.Context, data *SubscriptionPromotionalOfferResourceModel, offer *subscriptions.PromotionalOffer) diag.Diagnostics {
+	data.ID = types.StringValue(offer.ID)
+	data.SubscriptionID = types.StringValue(offer.SubscriptionID)
+	data.Name = types.StringValue(offer.Name)
+	data.OfferCode = types.StringValue(offer.OfferCode)
+	data.OfferMode = types.StringValue(offer.OfferMode)
+	data.Duration = types.StringValue(offer.Duration)
+	data.NumberOfPeriods = types.Int64Value(offer.NumberOfPeriods)
+
+	var diags diag.Diagnostics
+	data.Prices, diags = offerPricesValue(ctx, offer.Prices)
+	return diags
+}
+
+func (r *SubscriptionPromotionalOfferResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SubscriptionPromotionalOfferResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prices, diags := offerPricesFrom(ctx, data.Prices)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offer, err := r.client.CreateSubscriptionPromotionalOffer(ctx, subscriptions.PromotionalOffer{
+		SubscriptionID:  data.SubscriptionID.ValueString(),
+		Name:            data.Name.ValueString(),
+		OfferCode:       data.OfferCode.ValueString(),
+		OfferMode:       data.OfferMode.ValueString(),
+		Duration:        data.Duration.ValueString(),
+		NumberOfPeriods: data.NumberOfPeriods.ValueInt64(),
+		Prices:          prices,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create promotional offer, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a subscription promotional offer")
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, offer)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionPromotionalOfferResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SubscriptionPromotionalOfferResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offer, err := r.client.GetSubscriptionPromotionalOffer(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read promotional offer, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, offer)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionPromotionalOfferResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SubscriptionPromotionalOfferResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prices, diags := offerPricesFrom(ctx, data.Prices)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offer, err := r.client.ModifySubscriptionPromotionalOffer(ctx, data.ID.ValueString(), subscriptions.PromotionalOffer{
+		Prices: prices,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify promotional offer, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified a subscription promotional offer")
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, offer)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionPromotionalOfferResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SubscriptionPromotionalOfferResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSubscriptionPromotionalOffer(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete promotional offer, got error: %s", err))
+		return
+	}
+}
+
+func (r *SubscriptionPromotionalOfferResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
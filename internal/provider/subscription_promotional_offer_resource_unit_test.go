@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/subscriptions"
+)
+
+type mockSubscriptionPromotionalOfferClient struct {
+	created *subscriptions.PromotionalOffer
+}
+
+func (m *mockSubscriptionPromotionalOfferClient) CreateSubscriptionPromotionalOffer(ctx context.Context, offer subscriptions.PromotionalOffer) (*subscriptions.PromotionalOffer, error) {
+	m.created = &offer
+	offer.ID = "offer-id"
+	return &offer, nil
+}
+
+func (m *mockSubscriptionPromotionalOfferClient) GetSubscriptionPromotionalOffer(ctx context.Context, id string) (*subscriptions.PromotionalOffer, error) {
+	return &subscriptions.PromotionalOffer{ID: id}, nil
+}
+
+func (m *mockSubscriptionPromotionalOfferClient) ModifySubscriptionPromotionalOffer(ctx context.Context, id string, offer subscriptions.PromotionalOffer) (*subscriptions.PromotionalOffer, error) {
+	offer.ID = id
+	return &offer, nil
+}
+
+func (m *mockSubscriptionPromotionalOfferClient) DeleteSubscriptionPromotionalOffer(ctx context.Context, id string) error {
+	return nil
+}
+
+func subscriptionPromotionalOfferResourceSchema() schema.Schema {
+	r := &SubscriptionPromotionalOfferResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func offerPriceVal(territory string, pricePointID string) tftypes.Value {
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"territory":      tftypes.String,
+		"price_point_id": tftypes.String,
+	}}, map[string]tftypes.Value{
+		"territory":      tftypes.NewValue(tftypes.String, territory),
+		"price_point_id": tftypes.NewValue(tftypes.String, pricePointID),
+	})
+}
+
+func offerPricesVal(prices ...tftypes.Value) tftypes.Value {
+	return tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"territory":      tftypes.String,
+		"price_point_id": tftypes.String,
+	}}}, prices)
+}
+
+func subscriptionPromotionalOfferVal(s schema.Schema, mode string, prices tftypes.Value) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, nil),
+		"subscription_id":   tftypes.NewValue(tftypes.String, "subscription-id"),
+		"name":              tftypes.NewValue(tftypes.String, "Retention - Half Price"),
+		"offer_code":        tftypes.NewValue(tftypes.String, "retention_half_price"),
+		"offer_mode":        tftypes.NewValue(tftypes.String, mode),
+		"duration":          tftypes.NewValue(tftypes.String, "THREE_MONTHS"),
+		"number_of_periods": tftypes.NewValue(tftypes.Number, 1),
+		"price":             prices,
+	})
+}
+
+func TestSubscriptionPromotionalOfferResource_Create_SendsPrices(t *testing.T) {
+	client := &mockSubscriptionPromotionalOfferClient{}
+	r := &SubscriptionPromotionalOfferResource{client: client}
+
+	s := subscriptionPromotionalOfferResourceSchema()
+	planVal := subscriptionPromotionalOfferVal(s, "PAY_UP_FRONT", offerPricesVal(
+		offerPriceVal("USA", "usa-749"),
+		offerPriceVal("GBR", "gbr-599"),
+	))
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if len(client.created.Prices) != 2 {
+		t.Errorf("expected 2 prices to be sent, got %v", client.created.Prices)
+	}
+	if client.created.OfferCode != "retention_half_price" {
+		t.Errorf("expected offer code 'retention_half_price', got %q", client.created.OfferCode)
+	}
+
+	var data SubscriptionPromotionalOfferResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.ID.ValueString() != "offer-id" {
+		t.Errorf("expected ID 'offer-id', got %q", data.ID.ValueString())
+	}
+	if len(data.Prices.Elements()) != 2 {
+		t.Errorf("expected 2 prices in state, got %d", len(data.Prices.Elements()))
+	}
+}
+
+func TestSubscriptionPromotionalOfferResource_ValidateConfig_RequiresPricesForPaidOffers(t *testing.T) {
+	r := SubscriptionPromotionalOfferResource{}
+
+	s := subscriptionPromotionalOfferResourceSchema()
+	req := resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: s, Raw: subscriptionPromotionalOfferVal(s, "PAY_UP_FRONT", offerPricesVal())},
+	}
+	resp := &resource.ValidateConfigResponse{}
+
+	r.ValidateConfig(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for a paid offer without prices")
+	}
+}
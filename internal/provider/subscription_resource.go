@@ -0,0 +1,379 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/subscriptions"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SubscriptionResource{}
+var _ resource.ResourceWithImportState = &SubscriptionResource{}
+var _ resource.ResourceWithValidateConfig = &SubscriptionResource{}
+var _ resource.ResourceWithModifyPlan = &SubscriptionResource{}
+
+// subscriptionPeriods are the renewal periods App Store Connect supports.
+var subscriptionPeriods = []string{"ONE_WEEK", "ONE_MONTH", "TWO_MONTHS", "THREE_MONTHS", "SIX_MONTHS", "ONE_YEAR"}
+
+type subscriptionClient interface {
+	CreateSubscription(ctx context.Context, subscription subscriptions.Subscription) (*subscriptions.Subscription, error)
+	GetSubscription(ctx context.Context, id string) (*subscriptions.Subscription, error)
+	ModifySubscription(ctx context.Context, id string, subscription subscriptions.Subscription) (*subscriptions.Subscription, error)
+	DeleteSubscription(ctx context.Context, id string) error
+	ListSubscriptionLocalizations(ctx context.Context, subscriptionID string) ([]subscriptions.Localization, error)
+	CreateSubscriptionLocalization(ctx context.Context, localization subscriptions.Localization) (*subscriptions.Localization, error)
+	ModifySubscriptionLocalization(ctx context.Context, id string, localization subscriptions.Localization) (*subscriptions.Localization, error)
+	DeleteSubscriptionLocalization(ctx context.Context, id string) error
+}
+
+func NewSubscriptionResource() resource.Resource {
+	return &SubscriptionResource{}
+}
+
+// SubscriptionResource defines the resource implementation.
+type SubscriptionResource struct {
+	client subscriptionClient
+}
+
+// SubscriptionResourceModel describes the resource data model.
+type SubscriptionResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	SubscriptionGroupID types.String `tfsdk:"subscription_group_id"`
+	ProductID           types.String `tfsdk:"product_id"`
+	ReferenceName       types.String `tfsdk:"reference_name"`
+	Duration            types.String `tfsdk:"duration"`
+	GroupLevel          types.Int64  `tfsdk:"group_level"`
+	FamilySharable      types.Bool   `tfsdk:"family_sharable"`
+	ReviewNote          types.String `tfsdk:"review_note"`
+	State               types.String `tfsdk:"state"`
+	Localizations       types.Set    `tfsdk:"localization"`
+}
+
+func (r *SubscriptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription"
+}
+
+func (r *SubscriptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an auto-renewable subscription within a subscription group. " +
+			"If its localizations can't be added, the subscription is created but tainted; as product IDs can never be reused, " +
+			"run `terraform untaint` before applying again so the localizations are added without replacing it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the subscription.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscription_group_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the subscription group the subscription belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"product_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The product identifier used by StoreKit, e.g. `com.example.app.pro.monthly`. Product IDs can never be reused.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reference_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name used for the subscription in App Store Connect and sales reports.",
+			},
+			"duration": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "How often the subscription renews: `ONE_WEEK`, `ONE_MONTH`, `TWO_MONTHS`, `THREE_MONTHS`, `SIX_MONTHS` or `ONE_YEAR`.",
+			},
+			"group_level": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The rank of the subscription within its group, where `1` is the highest level of service. Defaults to the level after the lowest existing subscription.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"family_sharable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the subscription can be shared with Family Sharing. Once enabled, it cannot be turned off.",
+			},
+			"review_note": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Notes for App Review about the subscription.",
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The review state of the subscription, e.g. `MISSING_METADATA` or `APPROVED`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"localization": productLocalizationBlock("subscription"),
+		},
+	}
+}
+
+func (r *SubscriptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(subscriptionClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected subscriptionClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r SubscriptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SubscriptionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Duration.IsNull() && !data.Duration.IsUnknown() && !slices.Contains(subscriptionPeriods, data.Duration.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("duration"),
+			"Invalid Configuration",
+			fmt.Sprintf("`duration` must be one of %s, got %q.", strings.Join(subscriptionPeriods, ", "), data.Duration.ValueString()),
+		)
+	}
+
+	if !data.GroupLevel.IsNull() && !data.GroupLevel.IsUnknown() && data.GroupLevel.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("group_level"),
+			"Invalid Configuration",
+			fmt.Sprintf("`group_level` must be at least 1, got %d.", data.GroupLevel.ValueInt64()),
+		)
+	}
+
+	resp.Diagnostics.Append(validateProductLocalizations(ctx, data.Localizations)...)
+}
+
+func (r SubscriptionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(validateFamilySharableChange(ctx, req, "a subscription")...)
+}
+
+func (r *SubscriptionResource) populateState(ctx context.Context, data *SubscriptionResourceModel, subscription *subscriptions.Subscription, localizations []subscriptions.Localization) diag.Diagnostics {
+	data.ID = types.StringValue(subscription.ID)
+	data.SubscriptionGroupID = types.StringValue(subscription.GroupID)
+	data.ProductID = types.StringValue(subscription.ProductID)
+	data.ReferenceName = types.StringValue(subscription.Name)
+	data.Duration = types.StringValue(subscription.Period)
+	data.GroupLevel = types.Int64Value(subscription.GroupLevel)
+	data.FamilySharable = types.BoolValue(subscription.FamilySharable)
+	data.ReviewNote = optionalString(subscription.ReviewNote)
+	data.State = optionalString(subscription.State)
+
+	converted := []productLocalization{}
+	for _, localization := range localizations {
+		converted = append(converted, productLocalization{
+			ID:          localization.ID,
+			Locale:      localization.Locale,
+			Name:        localization.Name,
+			Description: localization.Description,
+		})
+	}
+
+	var diags diag.Diagnostics
+	data.Localizations, diags = productLocalizationsValue(ctx, converted)
+	return diags
+}
+
+func (r *SubscriptionResource) subscription(data *SubscriptionResourceModel) subscriptions.Subscription {
+	subscription := subscriptions.Subscription{
+		GroupID:        data.SubscriptionGroupID.ValueString(),
+		ProductID:      data.ProductID.ValueString(),
+		Name:           data.ReferenceName.ValueString(),
+		Period:         data.Duration.ValueString(),
+		FamilySharable: data.FamilySharable.ValueBool(),
+		ReviewNote:     data.ReviewNote.ValueString(),
+	}
+	// Leave the level unset so App Store Connect places the subscription itself.
+	if !data.GroupLevel.IsUnknown() {
+		subscription.GroupLevel = data.GroupLevel.ValueInt64()
+	}
+	return subscription
+}
+
+// syncLocalizations brings the localizations in line with the plan and
+// records the result, along with the subscription, in data.
+func (r *SubscriptionResource) syncLocalizations(ctx context.Context, data *SubscriptionResourceModel, subscription *subscriptions.Subscription) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	existing, err := r.client.ListSubscriptionLocalizations(ctx, subscription.ID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list subscription localizations, got error: %s", err))
+		return diags
+	}
+
+	current := []productLocalization{}
+	for _, localization := range existing {
+		current = append(current, productLocalization{
+			ID:          localization.ID,
+			Locale:      localization.Locale,
+			Name:        localization.Name,
+			Description: localization.Description,
+		})
+	}
+
+	toLocalization := func(localization productLocalization) subscriptions.Localization {
+		return subscriptions.Localization{
+			SubscriptionID: subscription.ID,
+			Locale:         localization.Locale,
+			Name:           localization.Name,
+			Description:    localization.Description,
+		}
+	}
+
+	diags.Append(reconcileProductLocalizations(ctx, data.Localizations, current, productLocalizationWriter{
+		create: func(ctx context.Context, localization productLocalization) error {
+			_, err := r.client.CreateSubscriptionLocalization(ctx, toLocalization(localization))
+			return err
+		},
+		modify: func(ctx context.Context, id string, localization productLocalization) error {
+			_, err := r.client.ModifySubscriptionLocalization(ctx, id, toLocalization(localization))
+			return err
+		},
+		delete: r.client.DeleteSubscriptionLocalization,
+	})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	existing, err = r.client.ListSubscriptionLocalizations(ctx, subscription.ID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list subscription localizations, got error: %s", err))
+		return diags
+	}
+
+	diags.Append(r.populateState(ctx, data, subscription, existing)...)
+	return diags
+}
+
+func (r *SubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SubscriptionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscription, err := r.client.CreateSubscription(ctx, r.subscription(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create subscription, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a subscription")
+
+	// Save the subscription before adding its localizations.
+	created := data
+	resp.Diagnostics.Append(r.populateState(ctx, &created, subscription, nil)...)
+	resp.Diagnostics.Append(saveCreated(ctx, &resp.State, &created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncLocalizations(ctx, &data, subscription)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SubscriptionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscription, err := r.client.GetSubscription(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read subscription, got error: %s", err))
+		return
+	}
+
+	localizations, err := r.client.ListSubscriptionLocalizations(ctx, subscription.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list subscription localizations, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, subscription, localizations)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SubscriptionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscription, err := r.client.ModifySubscription(ctx, data.ID.ValueString(), r.subscription(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify subscription, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified a subscription")
+
+	resp.Diagnostics.Append(r.syncLocalizations(ctx, &data, subscription)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SubscriptionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSubscription(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete subscription, got error: %s", err))
+		return
+	}
+}
+
+func (r *SubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
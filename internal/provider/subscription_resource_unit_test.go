@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/subscriptions"
+)
+
+type mockSubscriptionClient struct {
+	created       *subscriptions.Subscription
+	localizations []subscriptions.Localization
+	createErr     error
+}
+
+func (m *mockSubscriptionClient) CreateSubscription(ctx context.Context, subscription subscriptions.Subscription) (*subscriptions.Subscription, error) {
+	sent := subscription
+	m.created = &sent
+	subscription.ID = "subscription-id"
+	subscription.State = "MISSING_METADATA"
+	if subscription.GroupLevel == 0 {
+		subscription.GroupLevel = 3
+	}
+	return &subscription, nil
+}
+
+func (m *mockSubscriptionClient) GetSubscription(ctx context.Context, id string) (*subscriptions.Subscription, error) {
+	return &subscriptions.Subscription{ID: id}, nil
+}
+
+func (m *mockSubscriptionClient) ModifySubscription(ctx context.Context, id string, subscription subscriptions.Subscription) (*subscriptions.Subscription, error) {
+	subscription.ID = id
+	return &subscription, nil
+}
+
+func (m *mockSubscriptionClient) DeleteSubscription(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *mockSubscriptionClient) ListSubscriptionLocalizations(ctx context.Context, subscriptionID string) ([]subscriptions.Localization, error) {
+	return m.localizations, nil
+}
+
+func (m *mockSubscriptionClient) CreateSubscriptionLocalization(ctx context.Context, localization subscriptions.Localization) (*subscriptions.Localization, error) {
+	if m.createErr != nil {
+		return nil, m.createErr
+	}
+	localization.ID = fmt.Sprintf("%s-id", localization.Locale)
+	m.localizations = append(m.localizations, localization)
+	return &localization, nil
+}
+
+func (m *mockSubscriptionClient) ModifySubscriptionLocalization(ctx context.Context, id string, localization subscriptions.Localization) (*subscriptions.Localization, error) {
+	return &localization, nil
+}
+
+func (m *mockSubscriptionClient) DeleteSubscriptionLocalization(ctx context.Context, id string) error {
+	return nil
+}
+
+func subscriptionResourceSchema() schema.Schema {
+	r := &SubscriptionResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func subscriptionVal(s schema.Schema, id interface{}, duration string, groupLevel interface{}, familySharable bool) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                    tftypes.NewValue(tftypes.String, id),
+		"subscription_group_id": tftypes.NewValue(tftypes.String, "group-id"),
+		"product_id":            tftypes.NewValue(tftypes.String, "com.example.app.pro.monthly"),
+		"reference_name":        tftypes.NewValue(tftypes.String, "Pro Monthly"),
+		"duration":              tftypes.NewValue(tftypes.String, duration),
+		"group_level":           tftypes.NewValue(tftypes.Number, groupLevel),
+		"family_sharable":       tftypes.NewValue(tftypes.Bool, familySharable),
+		"review_note":           tftypes.NewValue(tftypes.String, nil),
+		"state":                 tftypes.NewValue(tftypes.String, nil),
+		"localization": productLocalizationsVal(
+			productLocalizationVal("en-GB", "Pro Monthly", "Unlock every feature."),
+		),
+	})
+}
+
+func TestSubscriptionResource_Create_LeavesUnknownGroupLevelToAppStoreConnect(t *testing.T) {
+	client := &mockSubscriptionClient{}
+	r := &SubscriptionResource{client: client}
+
+	s := subscriptionResourceSchema()
+	planVal := subscriptionVal(s, nil, "ONE_MONTH", tftypes.UnknownValue, false)
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: planVal},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if client.created.GroupLevel != 0 {
+		t.Errorf("expected no group level to be sent, got %d", client.created.GroupLevel)
+	}
+	if client.created.Period != "ONE_MONTH" {
+		t.Errorf("expected period ONE_MONTH, got %q", client.created.Period)
+	}
+
+	var data SubscriptionResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.GroupLevel.ValueInt64() != 3 {
+		t.Errorf("expected group level 3 from App Store Connect, got %d", data.GroupLevel.ValueInt64())
+	}
+	if len(data.Localizations.Elements()) != 1 {
+		t.Errorf("expected 1 localization in state, got %d", len(data.Localizations.Elements()))
+	}
+}
+
+func TestSubscriptionResource_Create_SavesSubscriptionWhenLocalizationsFail(t *testing.T) {
+	client := &mockSubscriptionClient{createErr: fmt.Errorf("locale not supported")}
+	r := &SubscriptionResource{client: client}
+
+	s := subscriptionResourceSchema()
+	planVal := subscriptionVal(s, tftypes.UnknownValue, "ONE_MONTH", tftypes.UnknownValue, false)
+
+	state := createSavedState(t, r, s, planVal, "subscription-id")
+
+	var data SubscriptionResourceModel
+	state.Get(context.Background(), &data)
+
+	if len(data.Localizations.Elements()) != 0 {
+		t.Errorf("expected no localizations in state, got %d", len(data.Localizations.Elements()))
+	}
+}
+
+func TestSubscriptionResource_ValidateConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		duration    string
+		groupLevel  interface{}
+		expectError bool
+	}{
+		{name: "valid", duration: "ONE_YEAR", groupLevel: 1},
+		{name: "no group level", duration: "ONE_WEEK", groupLevel: nil},
+		{name: "unsupported duration", duration: "TWO_WEEKS", groupLevel: 1, expectError: true},
+		{name: "zero group level", duration: "ONE_MONTH", groupLevel: 0, expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := SubscriptionResource{}
+
+			s := subscriptionResourceSchema()
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: subscriptionVal(s, nil, tc.duration, tc.groupLevel, false)},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error=%t, got diagnostics %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestSubscriptionResource_ModifyPlan_RejectsDisablingFamilySharing(t *testing.T) {
+	r := SubscriptionResource{}
+
+	s := subscriptionResourceSchema()
+	stateVal := subscriptionVal(s, "subscription-id", "ONE_MONTH", 1, true)
+	planVal := subscriptionVal(s, "subscription-id", "ONE_MONTH", 1, false)
+
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+	resp := &resource.ModifyPlanResponse{
+		Plan: tfsdk.Plan{Schema: s, Raw: planVal},
+	}
+
+	r.ModifyPlan(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when disabling Family Sharing")
+	}
+}
@@ -0,0 +1,345 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oliver-binns/appstore-go/subscriptions"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SubscriptionWinBackOfferResource{}
+var _ resource.ResourceWithImportState = &SubscriptionWinBackOfferResource{}
+var _ resource.ResourceWithValidateConfig = &SubscriptionWinBackOfferResource{}
+
+// winBackOfferPriorities are the priorities App Store Connect uses to choose
+// between win-back offers a customer is eligible for.
+var winBackOfferPriorities = []string{"HIGH", "NORMAL"}
+
+type subscriptionWinBackOfferClient interface {
+	CreateSubscriptionWinBackOffer(ctx context.Context, offer subscriptions.WinBackOffer) (*subscriptions.WinBackOffer, error)
+	GetSubscriptionWinBackOffer(ctx context.Context, id string) (*subscriptions.WinBackOffer, error)
+	ModifySubscriptionWinBackOffer(ctx context.Context, id string, offer subscriptions.WinBackOffer) (*subscriptions.WinBackOffer, error)
+	DeleteSubscriptionWinBackOffer(ctx context.Context, id string) error
+}
+
+func NewSubscriptionWinBackOfferResource() resource.Resource {
+	return &SubscriptionWinBackOfferResource{}
+}
+
+// SubscriptionWinBackOfferResource defines the resource implementation.
+type SubscriptionWinBackOfferResource struct {
+	client subscriptionWinBackOfferClient
+}
+
+// SubscriptionWinBackOfferResourceModel describes the resource data model.
+type SubscriptionWinBackOfferResourceModel struct {
+	ID                               types.String `tfsdk:"id"`
+	SubscriptionID                   types.String `tfsdk:"subscription_id"`
+	ReferenceName                    types.String `tfsdk:"reference_name"`
+	OfferID                          types.String `tfsdk:"offer_id"`
+	OfferMode                        types.String `tfsdk:"offer_mode"`
+	Duration                         types.String `tfsdk:"duration"`
+	NumberOfPeriods                  types.Int64  `tfsdk:"number_of_periods"`
+	PaidSubscriptionDurationMonths   types.Int64  `tfsdk:"paid_subscription_duration_months"`
+	TimeSinceLastSubscribedMinMonths types.Int64  `tfsdk:"time_since_last_subscribed_min_months"`
+	TimeSinceLastSubscribedMaxMonths types.Int64  `tfsdk:"time_since_last_subscribed_max_months"`
+	WaitBetweenOffersMonths          types.Int64  `tfsdk:"wait_between_offers_months"`
+	StartDate                        types.String `tfsdk:"start_date"`
+	EndDate                          types.String `tfsdk:"end_date"`
+	Priority                         types.String `tfsdk:"priority"`
+	Prices                           types.Set    `tfsdk:"price"`
+}
+
+func (r *SubscriptionWinBackOfferResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription_win_back_offer"
+}
+
+func (r *SubscriptionWinBackOfferResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	prices := offerPriceBlock()
+	prices.PlanModifiers = []planmodifier.Set{
+		setplanmodifier.RequiresReplace(),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a win-back offer that App Store Connect shows to lapsed subscribers who meet its eligibility rules. " +
+			"The eligibility rules, dates and priority can be changed once the offer has been created.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the win-back offer.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscription_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the subscription the offer applies to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reference_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name used for the offer in App Store Connect.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"offer_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier StoreKit reports when a customer redeems the offer.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"offer_mode": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "How customers pay for the offer: `FREE_TRIAL`, `PAY_AS_YOU_GO` or `PAY_UP_FRONT`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"duration": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The length of each offer period, e.g. `ONE_WEEK` or `THREE_MONTHS`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"number_of_periods": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "The number of periods the offer lasts. Only pay-as-you-go offers can have more than one.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"paid_subscription_duration_months": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "How many months a customer must have paid for the subscription to be eligible.",
+			},
+			"time_since_last_subscribed_min_months": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The fewest months since the customer's subscription lapsed for them to be eligible.",
+			},
+			"time_since_last_subscribed_max_months": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The most months since the customer's subscription lapsed for them to be eligible.",
+			},
+			"wait_between_offers_months": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "How many months a customer must wait after redeeming a win-back offer before being offered this one.",
+			},
+			"start_date": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The date the offer becomes available, in `YYYY-MM-DD` format.",
+			},
+			"end_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The date the offer stops being available, in `YYYY-MM-DD` format. Omit to run indefinitely.",
+			},
+			"priority": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("NORMAL"),
+				MarkdownDescription: "Which offer is shown when a customer is eligible for more than one: `HIGH` or `NORMAL`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"price": prices,
+		},
+	}
+}
+
+func (r *SubscriptionWinBackOfferResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(subscriptionWinBackOfferClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected subscriptionWinBackOfferClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r SubscriptionWinBackOfferResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SubscriptionWinBackOfferResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateSubscriptionOffer(data.OfferMode, data.Duration, data.NumberOfPeriods, offerPriced(data.Prices), path.Root("price"))...)
+
+	if !data.Priority.IsNull() && !data.Priority.IsUnknown() && !slices.Contains(winBackOfferPriorities, data.Priority.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("priority"),
+			"Invalid Configuration",
+			fmt.Sprintf("`priority` must be one of %s, got %q.", strings.Join(winBackOfferPriorities, ", "), data.Priority.ValueString()),
+		)
+	}
+
+	minimum, maximum := data.TimeSinceLastSubscribedMinMonths, data.TimeSinceLastSubscribedMaxMonths
+	if !minimum.IsNull() && !minimum.IsUnknown() && !maximum.IsNull() && !maximum.IsUnknown() && minimum.ValueInt64() > maximum.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("time_since_last_subscribed_max_months"),
+			"Invalid Configuration",
+			fmt.Sprintf("`time_since_last_subscribed_max_months` must be at least `time_since_last_subscribed_min_months` (%d), got %d.", minimum.ValueInt64(), maximum.ValueInt64()),
+		)
+	}
+}
+
+func (r *SubscriptionWinBackOfferResource) populateState(ctx context.Context, data *SubscriptionWinBackOfferResourceModel, offer *subscriptions.WinBackOffer) diag.Diagnostics {
+	data.ID = types.StringValue(offer.ID)
+	data.SubscriptionID = types.StringValue(offer.SubscriptionID)
+	data.ReferenceName = types.StringValue(offer.ReferenceName)
+	data.OfferID = types.StringValue(offer.OfferID)
+	data.OfferMode = types.StringValue(offer.OfferMode)
+	data.Duration = types.StringValue(offer.Duration)
+	data.NumberOfPeriods = types.Int64Value(offer.NumberOfPeriods)
+	data.PaidSubscriptionDurationMonths = types.Int64Value(offer.PaidSubscriptionDurationMonths)
+	data.TimeSinceLastSubscribedMinMonths = types.Int64Value(offer.TimeSinceLastSubscribedMinMonths)
+	data.TimeSinceLastSubscribedMaxMonths = types.Int64Value(offer.TimeSinceLastSubscribedMaxMonths)
+	data.WaitBetweenOffersMonths = types.Int64PointerValue(offer.WaitBetweenOffersMonths)
+	data.StartDate = types.StringValue(offer.StartDate)
+	data.EndDate = optionalString(offer.EndDate)
+	data.Priority = types.StringValue(offer.Priority)
+
+	var diags diag.Diagnostics
+	data.Prices, diags = offerPricesValue(ctx, offer.Prices)
+	return diags
+}
+
+// eligibility returns the parts of an offer that can be changed after it is created.
+func (r *SubscriptionWinBackOfferResource) eligibility(data *SubscriptionWinBackOfferResourceModel) subscriptions.WinBackOffer {
+	return subscriptions.WinBackOffer{
+		PaidSubscriptionDurationMonths:   data.PaidSubscriptionDurationMonths.ValueInt64(),
+		TimeSinceLastSubscribedMinMonths: data.TimeSinceLastSubscribedMinMonths.ValueInt64(),
+		TimeSinceLastSubscribedMaxMonths: data.TimeSinceLastSubscribedMaxMonths.ValueInt64(),
+		WaitBetweenOffersMonths:          data.WaitBetweenOffersMonths.ValueInt64Pointer(),
+		StartDate:                        data.StartDate.ValueString(),
+		EndDate:                          data.EndDate.ValueString(),
+		Priority:                         data.Priority.ValueString(),
+	}
+}
+
+func (r *SubscriptionWinBackOfferResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SubscriptionWinBackOfferResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prices, diags := offerPricesFrom(ctx, data.Prices)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offer := r.eligibility(&data)
+	offer.SubscriptionID = data.SubscriptionID.ValueString()
+	offer.ReferenceName = data.ReferenceName.ValueString()
+	offer.OfferID = data.OfferID.ValueString()
+	offer.OfferMode = data.OfferMode.ValueString()
+	offer.Duration = data.Duration.ValueString()
+	offer.NumberOfPeriods = data.NumberOfPeriods.ValueInt64()
+	offer.Prices = prices
+
+	created, err := r.client.CreateSubscriptionWinBackOffer(ctx, offer)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create win-back offer, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a win-back offer")
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, created)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionWinBackOfferResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SubscriptionWinBackOfferResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offer, err := r.client.GetSubscriptionWinBackOffer(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read win-back offer, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, offer)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionWinBackOfferResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SubscriptionWinBackOfferResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offer, err := r.client.ModifySubscriptionWinBackOffer(ctx, data.ID.ValueString(), r.eligibility(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to modify win-back offer, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "modified a win-back offer")
+
+	resp.Diagnostics.Append(r.populateState(ctx, &data, offer)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionWinBackOfferResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SubscriptionWinBackOfferResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSubscriptionWinBackOffer(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete win-back offer, got error: %s", err))
+		return
+	}
+}
+
+func (r *SubscriptionWinBackOfferResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
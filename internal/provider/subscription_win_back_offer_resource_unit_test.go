@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/subscriptions"
+)
+
+type mockSubscriptionWinBackOfferClient struct {
+	modified *subscriptions.WinBackOffer
+}
+
+func (m *mockSubscriptionWinBackOfferClient) CreateSubscriptionWinBackOffer(ctx context.Context, offer subscriptions.WinBackOffer) (*subscriptions.WinBackOffer, error) {
+	offer.ID = "offer-id"
+	return &offer, nil
+}
+
+func (m *mockSubscriptionWinBackOfferClient) GetSubscriptionWinBackOffer(ctx context.Context, id string) (*subscriptions.WinBackOffer, error) {
+	return &subscriptions.WinBackOffer{ID: id}, nil
+}
+
+func (m *mockSubscriptionWinBackOfferClient) ModifySubscriptionWinBackOffer(ctx context.Context, id string, offer subscriptions.WinBackOffer) (*subscriptions.WinBackOffer, error) {
+	m.modified = &offer
+	updated := offer
+	updated.ID = id
+	updated.SubscriptionID = "subscription-id"
+	updated.ReferenceName = "Come Back - Free Month"
+	updated.OfferID = "come_back_free_month"
+	updated.OfferMode = "FREE_TRIAL"
+	updated.Duration = "ONE_MONTH"
+	updated.NumberOfPeriods = 1
+	return &updated, nil
+}
+
+func (m *mockSubscriptionWinBackOfferClient) DeleteSubscriptionWinBackOffer(ctx context.Context, id string) error {
+	return nil
+}
+
+func subscriptionWinBackOfferResourceSchema() schema.Schema {
+	r := &SubscriptionWinBackOfferResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+func subscriptionWinBackOfferVal(s schema.Schema, id interface{}, minMonths int64, maxMonths int64, wait interface{}, priority string) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":                                    tftypes.NewValue(tftypes.String, id),
+		"subscription_id":                       tftypes.NewValue(tftypes.String, "subscription-id"),
+		"reference_name":                        tftypes.NewValue(tftypes.String, "Come Back - Free Month"),
+		"offer_id":                              tftypes.NewValue(tftypes.String, "come_back_free_month"),
+		"offer_mode":                            tftypes.NewValue(tftypes.String, "FREE_TRIAL"),
+		"duration":                              tftypes.NewValue(tftypes.String, "ONE_MONTH"),
+		"number_of_periods":                     tftypes.NewValue(tftypes.Number, 1),
+		"paid_subscription_duration_months":     tftypes.NewValue(tftypes.Number, 3),
+		"time_since_last_subscribed_min_months": tftypes.NewValue(tftypes.Number, minMonths),
+		"time_since_last_subscribed_max_months": tftypes.NewValue(tftypes.Number, maxMonths),
+		"wait_between_offers_months":            tftypes.NewValue(tftypes.Number, wait),
+		"start_date":                            tftypes.NewValue(tftypes.String, "2026-01-01"),
+		"end_date":                              tftypes.NewValue(tftypes.String, nil),
+		"priority":                              tftypes.NewValue(tftypes.String, priority),
+		"price":                                 offerPricesVal(),
+	})
+}
+
+func TestSubscriptionWinBackOfferResource_Update_SendsEligibility(t *testing.T) {
+	client := &mockSubscriptionWinBackOfferClient{}
+	r := &SubscriptionWinBackOfferResource{client: client}
+
+	s := subscriptionWinBackOfferResourceSchema()
+	stateVal := subscriptionWinBackOfferVal(s, "offer-id", 2, 12, nil, "NORMAL")
+	planVal := subscriptionWinBackOfferVal(s, "offer-id", 1, 24, 6, "HIGH")
+
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: s, Raw: planVal},
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: s, Raw: stateVal},
+	}
+
+	r.Update(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if client.modified.TimeSinceLastSubscribedMinMonths != 1 || client.modified.TimeSinceLastSubscribedMaxMonths != 24 {
+		t.Errorf("expected eligibility of 1-24 months, got %+v", client.modified)
+	}
+	if client.modified.WaitBetweenOffersMonths == nil || *client.modified.WaitBetweenOffersMonths != 6 {
+		t.Errorf("expected a 6 month wait between offers, got %v", client.modified.WaitBetweenOffersMonths)
+	}
+	if client.modified.Priority != "HIGH" {
+		t.Errorf("expected priority HIGH, got %q", client.modified.Priority)
+	}
+
+	var data SubscriptionWinBackOfferResourceModel
+	resp.State.Get(context.Background(), &data)
+
+	if data.WaitBetweenOffersMonths.ValueInt64() != 6 {
+		t.Errorf("expected a 6 month wait in state, got %d", data.WaitBetweenOffersMonths.ValueInt64())
+	}
+}
+
+func TestSubscriptionWinBackOfferResource_ValidateConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		minMonths   int64
+		maxMonths   int64
+		priority    string
+		expectError bool
+	}{
+		{name: "valid", minMonths: 2, maxMonths: 12, priority: "HIGH"},
+		{name: "unsupported priority", minMonths: 2, maxMonths: 12, priority: "LOW", expectError: true},
+		{name: "inverted eligibility", minMonths: 12, maxMonths: 2, priority: "NORMAL", expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := SubscriptionWinBackOfferResource{}
+
+			s := subscriptionWinBackOfferResourceSchema()
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: subscriptionWinBackOfferVal(s, nil, tc.minMonths, tc.maxMonths, nil, tc.priority)},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error=%t, got diagnostics %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/oliver-binns/appstore-go/availability"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TerritoriesDataSource{}
+
+type territoriesClient interface {
+	ListTerritories(ctx context.Context) ([]availability.Territory, error)
+}
+
+func NewTerritoriesDataSource() datasource.DataSource {
+	return &TerritoriesDataSource{}
+}
+
+// TerritoriesDataSource defines the data source implementation.
+type TerritoriesDataSource struct {
+	client territoriesClient
+}
+
+// TerritoriesDataSourceModel describes the data source data model.
+type TerritoriesDataSourceModel struct {
+	Territories types.List `tfsdk:"territories"`
+}
+
+type territoryModel struct {
+	Code     types.String `tfsdk:"code"`
+	Currency types.String `tfsdk:"currency"`
+}
+
+var territoryAttrTypes = map[string]attr.Type{
+	"code":     types.StringType,
+	"currency": types.StringType,
+}
+
+func (d *TerritoriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_territories"
+}
+
+func (d *TerritoriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists every territory the App Store is available in.",
+		Attributes: map[string]schema.Attribute{
+			"territories": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The territories, ordered by code.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The three-letter code of the territory, e.g. `GBR`.",
+						},
+						"currency": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The three-letter code of the currency customers in the territory pay in, e.g. `GBP`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TerritoriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(territoriesClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected territoriesClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TerritoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TerritoriesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	territories, err := d.client.ListTerritories(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list territories, got error: %s", err))
+		return
+	}
+
+	slices.SortFunc(territories, func(a, b availability.Territory) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	models := []territoryModel{}
+	for _, territory := range territories {
+		models = append(models, territoryModel{
+			Code:     types.StringValue(territory.ID),
+			Currency: types.StringValue(territory.Currency),
+		})
+	}
+
+	value, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: territoryAttrTypes}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Territories = value
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
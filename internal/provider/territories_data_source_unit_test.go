@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/oliver-binns/appstore-go/availability"
+)
+
+type mockTerritoriesClient struct{}
+
+func (m *mockTerritoriesClient) ListTerritories(ctx context.Context) ([]availability.Territory, error) {
+	return []availability.Territory{
+		{ID: "USA", Currency: "USD"},
+		{ID: "FRA", Currency: "EUR"},
+		{ID: "GBR", Currency: "GBP"},
+	}, nil
+}
+
+func TestTerritoriesDataSource_Read_OrdersByCode(t *testing.T) {
+	d := &TerritoriesDataSource{client: &mockTerritoriesClient{}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	configVal := tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"territories": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"code":     tftypes.String,
+			"currency": tftypes.String,
+		}}}, nil),
+	})
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: s, Raw: configVal},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: configVal},
+	}
+
+	d.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+
+	var data TerritoriesDataSourceModel
+	resp.State.Get(context.Background(), &data)
+
+	var territories []territoryModel
+	data.Territories.ElementsAs(context.Background(), &territories, false)
+
+	codes := []string{}
+	for _, territory := range territories {
+		codes = append(codes, territory.Code.ValueString())
+	}
+	if len(codes) != 3 || codes[0] != "FRA" || codes[1] != "GBR" || codes[2] != "USA" {
+		t.Errorf("expected territories ordered FRA, GBR, USA, got %v", codes)
+	}
+	if territories[1].Currency.ValueString() != "GBP" {
+		t.Errorf("expected GBR currency 'GBP', got %q", territories[1].Currency.ValueString())
+	}
+}
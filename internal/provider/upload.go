@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/oliver-binns/appstore-go/uploads"
+)
+
+// readAsset loads a local file to be uploaded and returns its contents along
+// with the MD5 checksum Apple stores as `sourceFileChecksum`.
+func readAsset(filePath string) ([]byte, string, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, "", err
+	}
+
+	sum := md5.Sum(data)
+	return data, hex.EncodeToString(sum[:]), nil
+}
+
+// uploadAsset performs the upload operations returned when an asset is
+// reserved, sending each byte range of the file to the URL Apple provided.
+// These URLs are pre-signed, so no App Store Connect credentials are sent.
+func uploadAsset(ctx context.Context, operations []uploads.Operation, data []byte) error {
+	for _, operation := range operations {
+		end := operation.Offset + operation.Length
+		if operation.Offset < 0 || end > int64(len(data)) {
+			return fmt.Errorf("upload operation range %d-%d exceeds file size %d", operation.Offset, end, len(data))
+		}
+
+		req, err := http.NewRequestWithContext(ctx, operation.Method, operation.URL, bytes.NewReader(data[operation.Offset:end]))
+		if err != nil {
+			return err
+		}
+		for name, value := range operation.RequestHeaders {
+			req.Header.Set(name, value)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return fmt.Errorf("upload operation to %s returned status %d", operation.URL, resp.StatusCode)
+		}
+	}
+
+	return nil
+}
+
+// assetOrder serialises reordering of assets within a set. Terraform creates
+// sibling resources in parallel, so it also remembers the position requested
+// for each asset during this run, letting the last writer produce the
+// configured order regardless of which asset finished uploading first.
+type assetOrder struct {
+	mu        sync.Mutex
+	positions map[string]int64
+}
+
+func newAssetOrder() *assetOrder {
+	return &assetOrder{positions: map[string]int64{}}
+}
+
+// place moves assetID to the requested 1-based position within the ordered
+// IDs and returns the new order.
+func (o *assetOrder) place(ids []string, assetID string, position int64) []string {
+	o.positions[assetID] = position
+
+	type entry struct {
+		id    string
+		key   int64
+		known bool
+	}
+	entries := make([]entry, 0, len(ids))
+	for i, id := range ids {
+		key, known := o.positions[id]
+		if !known {
+			key = int64(i + 1)
+		}
+		entries = append(entries, entry{id: id, key: key, known: known})
+	}
+
+	// Assets with a configured position win ties against those left where Apple put them.
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].key != entries[j].key {
+			return entries[i].key < entries[j].key
+		}
+		return entries[i].known && !entries[j].known
+	})
+
+	ordered := make([]string, 0, len(entries))
+	for _, e := range entries {
+		ordered = append(ordered, e.id)
+	}
+	return ordered
+}
+
+// reorder reads the current order of a set, places assetID at position and
+// writes the order back if it changed.
+func (o *assetOrder) reorder(
+	ctx context.Context,
+	setID string,
+	assetID string,
+	position int64,
+	list func(ctx context.Context, setID string) ([]string, error),
+	write func(ctx context.Context, setID string, ids []string) error,
+) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	ids, err := list(ctx, setID)
+	if err != nil {
+		return err
+	}
+
+	ordered := o.place(ids, assetID, position)
+	for i := range ids {
+		if ids[i] != ordered[i] {
+			return write(ctx, setID, ordered)
+		}
+	}
+	return nil
+}
+
+// positionOf returns the 1-based position of assetID, or 0 if it is not in the set.
+func positionOf(ids []string, assetID string) int64 {
+	for i, id := range ids {
+		if id == assetID {
+			return int64(i + 1)
+		}
+	}
+	return 0
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/oliver-binns/appstore-go/uploads"
+)
+
+func TestReadAsset_ReturnsMD5Checksum(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "screenshot.png")
+	if err := os.WriteFile(filePath, []byte("hello world"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	data, checksum, err := readAsset(filePath)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if string(data) != "hello world" {
+		t.Errorf("expected file contents to be returned, got %q", data)
+	}
+	if checksum != "5eb63bbbe01eeed093cb22bb8f5acdc3" {
+		t.Errorf("expected MD5 checksum '5eb63bbbe01eeed093cb22bb8f5acdc3', got %q", checksum)
+	}
+}
+
+func TestUploadAsset_SendsEachByteRange(t *testing.T) {
+	var mu sync.Mutex
+	received := map[string]string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		received[r.URL.Path] = string(body)
+		mu.Unlock()
+		if r.Header.Get("Content-Type") != "image/png" {
+			t.Errorf("expected request header to be forwarded, got %q", r.Header.Get("Content-Type"))
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	headers := map[string]string{"Content-Type": "image/png"}
+	operations := []uploads.Operation{
+		{Method: http.MethodPut, URL: server.URL + "/part-1", Offset: 0, Length: 5, RequestHeaders: headers},
+		{Method: http.MethodPut, URL: server.URL + "/part-2", Offset: 5, Length: 6, RequestHeaders: headers},
+	}
+
+	err := uploadAsset(context.Background(), operations, []byte("hello world"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if received["/part-1"] != "hello" {
+		t.Errorf("expected first part 'hello', got %q", received["/part-1"])
+	}
+	if received["/part-2"] != " world" {
+		t.Errorf("expected second part ' world', got %q", received["/part-2"])
+	}
+}
+
+func TestUploadAsset_ReturnsErrorOnFailedOperation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	operations := []uploads.Operation{
+		{Method: http.MethodPut, URL: server.URL, Offset: 0, Length: 5},
+	}
+
+	err := uploadAsset(context.Background(), operations, []byte("hello"))
+	if err == nil {
+		t.Fatal("expected an error when the upload is rejected")
+	}
+}
+
+func TestAssetOrder_PlacesAssetsCreatedOutOfOrder(t *testing.T) {
+	order := newAssetOrder()
+
+	// Screenshots 3, 2 and 1 finish uploading in reverse order, each being
+	// appended to the end of the set by Apple.
+	ids := order.place([]string{"three"}, "three", 3)
+	ids = order.place(append(ids, "two"), "two", 2)
+	ids = order.place(append(ids, "one"), "one", 1)
+
+	expected := []string{"one", "two", "three"}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("expected order %v, got %v", expected, ids)
+	}
+}
+
+func TestAssetOrder_Reorder_SkipsWriteWhenAlreadyOrdered(t *testing.T) {
+	order := newAssetOrder()
+
+	list := func(ctx context.Context, setID string) ([]string, error) {
+		return []string{"one", "two"}, nil
+	}
+	write := func(ctx context.Context, setID string, ids []string) error {
+		t.Errorf("expected no reorder, got %v", ids)
+		return nil
+	}
+
+	err := order.reorder(context.Background(), "set-id", "two", 2, list, write)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}